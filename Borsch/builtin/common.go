@@ -10,8 +10,7 @@ import (
 )
 
 func Assert(state common.State, expected common.Value, actual common.Value, errorTemplate string) error {
-	args := []common.Value{actual}
-	result, err := types.CallByName(state, expected, common.EqualsOp.Name(), &args, nil, true)
+	result, err := types.CallBinaryOperator(state, common.EqualsOp, expected, actual)
 	if err != nil {
 		return err
 	}
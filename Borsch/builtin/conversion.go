@@ -0,0 +1,143 @@
+package builtin
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/std"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+var (
+	ParseNumberFunction *types.FunctionInstance
+	FormatFunction      *types.FunctionInstance
+)
+
+func newDecimalCommaParameter() types.FunctionParameter {
+	return types.FunctionParameter{
+		Type:       types.Bool,
+		Name:       "десяткова_кома",
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
+
+func newSpecParameter() types.FunctionParameter {
+	return types.FunctionParameter{
+		Type:       types.String,
+		Name:       "специфікація",
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
+
+// newParseNumberFunction returns the parsed number and 'нуль', or 'нуль'
+// and the error if the string is not a number.
+func newParseNumberFunction(
+	parameters []types.FunctionParameter,
+	isDecimalComma func([]common.Value) bool,
+	doc string,
+) *types.FunctionInstance {
+	return types.NewFunctionInstance(
+		"розібрати_число",
+		append(
+			[]types.FunctionParameter{
+				{
+					Type:       types.String,
+					Name:       "рядок",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			parameters...,
+		),
+		func(_ common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			result := types.NewListInstance()
+			number, err := types.ParseNumber((*args)[0].(types.StringInstance).Value, isDecimalComma((*args)[1:]))
+			if err != nil {
+				result.Values = []common.Value{types.NewNilInstance(), std.NewErrorInstance(err.Error())}
+			} else {
+				result.Values = []common.Value{number, types.NewNilInstance()}
+			}
+
+			return result, nil
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       types.Any,
+				IsNullable: true,
+			},
+			{
+				Type:       std.ErrorClass,
+				IsNullable: true,
+			},
+		},
+		false,
+		types.BuiltinPackage,
+		doc,
+	)
+}
+
+func newFormatFunction(
+	parameters []types.FunctionParameter,
+	getArguments func([]common.Value) (string, bool),
+	doc string,
+) *types.FunctionInstance {
+	return newFunctionalBuiltin(
+		"формат",
+		append([]types.FunctionParameter{newAnyParameter("число", false)}, parameters...),
+		func(_ common.State, args []common.Value) (common.Value, error) {
+			spec, decimalComma := getArguments(args[1:])
+			text, err := types.Format(args[0], spec, decimalComma)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewStringInstance(text), nil
+		},
+		types.String,
+		doc,
+	)
+}
+
+func initConversionRuntime() {
+	ParseNumberFunction = types.MustOverload(
+		newParseNumberFunction(
+			[]types.FunctionParameter{},
+			func([]common.Value) bool {
+				return false
+			},
+			"Розбирає рядок як ціле або дійсне число, повертає число й нуль або нуль і помилку.",
+		),
+		newParseNumberFunction(
+			[]types.FunctionParameter{newDecimalCommaParameter()},
+			func(args []common.Value) bool {
+				return args[0].(types.BoolInstance).Value
+			},
+			"Розбирає рядок як ціле або дійсне число, в якому десятковим роздільником є кома, якщо вказано, "+
+				"повертає число й нуль або нуль і помилку.",
+		),
+	)
+
+	FormatFunction = types.MustOverload(
+		newFormatFunction(
+			[]types.FunctionParameter{},
+			func([]common.Value) (string, bool) {
+				return "", false
+			},
+			"Записує число в рядок.",
+		),
+		newFormatFunction(
+			[]types.FunctionParameter{newSpecParameter()},
+			func(args []common.Value) (string, bool) {
+				return args[0].(types.StringInstance).Value, false
+			},
+			"Записує число в рядок за специфікацією.",
+		),
+		newFormatFunction(
+			[]types.FunctionParameter{newSpecParameter(), newDecimalCommaParameter()},
+			func(args []common.Value) (string, bool) {
+				return args[0].(types.StringInstance).Value, args[1].(types.BoolInstance).Value
+			},
+			"Записує число в рядок за специфікацією, з десятковою комою замість крапки, якщо вказано.",
+		),
+	)
+}
@@ -0,0 +1,288 @@
+package builtin
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+var (
+	MapFunction       *types.FunctionInstance
+	FilterFunction    *types.FunctionInstance
+	ReduceFunction    *types.FunctionInstance
+	ZipFunction       *types.FunctionInstance
+	EnumerateFunction *types.FunctionInstance
+	AnyFunction       *types.FunctionInstance
+	AllFunction       *types.FunctionInstance
+	MinFunction       *types.FunctionInstance
+	MaxFunction       *types.FunctionInstance
+	SumFunction       *types.FunctionInstance
+	SortedFunction    *types.FunctionInstance
+)
+
+func newFunctionalBuiltin(
+	name string,
+	parameters []types.FunctionParameter,
+	handler func(common.State, []common.Value) (common.Value, error),
+	returnType *types.Class,
+	doc string,
+) *types.FunctionInstance {
+	return types.NewFunctionInstance(
+		name,
+		parameters,
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return handler(state, *args)
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       returnType,
+				IsNullable: returnType == types.Any,
+			},
+		},
+		false,
+		types.BuiltinPackage,
+		doc,
+	)
+}
+
+func newAnyParameter(name string, isVariadic bool) types.FunctionParameter {
+	return types.FunctionParameter{
+		Type:       types.Any,
+		Name:       name,
+		IsVariadic: isVariadic,
+		IsNullable: true,
+	}
+}
+
+func newReverseParameter() types.FunctionParameter {
+	return types.FunctionParameter{
+		Type:       types.Bool,
+		Name:       "зворотний",
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
+
+func newExtremeFunction(name string, op common.Operator, doc, keyDoc string) *types.FunctionInstance {
+	return types.MustOverload(
+		newFunctionalBuiltin(
+			name,
+			[]types.FunctionParameter{newAnyParameter("елементи", false)},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Extreme(state, name, args[0], types.NewNilInstance(), op)
+			},
+			types.Any,
+			doc,
+		),
+		newFunctionalBuiltin(
+			name,
+			[]types.FunctionParameter{newAnyParameter("елементи", false), newAnyParameter("ключ", false)},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Extreme(state, name, args[0], args[1], op)
+			},
+			types.Any,
+			keyDoc,
+		),
+	)
+}
+
+func newSortedFunction(
+	parameters []types.FunctionParameter,
+	getArguments func([]common.Value) (common.Value, bool),
+	doc string,
+) *types.FunctionInstance {
+	return newFunctionalBuiltin(
+		"відсортований",
+		append([]types.FunctionParameter{newAnyParameter("елементи", false)}, parameters...),
+		func(state common.State, args []common.Value) (common.Value, error) {
+			key, reverse := getArguments(args[1:])
+			return types.Sorted(state, args[0], key, reverse)
+		},
+		types.List,
+		doc,
+	)
+}
+
+func initFunctionalRuntime() {
+	MapFunction = newFunctionalBuiltin(
+		"відобразити",
+		[]types.FunctionParameter{newAnyParameter("функція", false), newAnyParameter("елементи", true)},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			return types.Map(state, args[0], args[1:])
+		},
+		types.Iterator,
+		"Повертає ітератор результатів функції для елементів ітерованих об'єктів на однакових позиціях, "+
+			"зупиняється на найкоротшому з них.",
+	)
+
+	FilterFunction = newFunctionalBuiltin(
+		"фільтрувати",
+		[]types.FunctionParameter{newAnyParameter("функція", false), newAnyParameter("елементи", false)},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			return types.Filter(state, args[0], args[1])
+		},
+		types.Iterator,
+		"Повертає ітератор елементів, для яких функція повертає істину.",
+	)
+
+	ReduceFunction = types.MustOverload(
+		newFunctionalBuiltin(
+			"згорнути",
+			[]types.FunctionParameter{newAnyParameter("функція", false), newAnyParameter("елементи", false)},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Reduce(state, args[0], args[1], nil)
+			},
+			types.Any,
+			"Послідовно застосовує функцію до накопиченого значення й кожного наступного елемента, "+
+				"першим накопиченим значенням є перший елемент.",
+		),
+		newFunctionalBuiltin(
+			"згорнути",
+			[]types.FunctionParameter{
+				newAnyParameter("функція", false),
+				newAnyParameter("елементи", false),
+				newAnyParameter("початкове", false),
+			},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Reduce(state, args[0], args[1], args[2])
+			},
+			types.Any,
+			"Послідовно застосовує функцію до накопиченого значення й кожного елемента, "+
+				"починаючи з початкового значення.",
+		),
+	)
+
+	ZipFunction = newFunctionalBuiltin(
+		"зшити",
+		[]types.FunctionParameter{newAnyParameter("елементи", true)},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			return types.Zip(state, args)
+		},
+		types.Iterator,
+		"Повертає ітератор списків з елементів ітерованих об'єктів на однакових позиціях, "+
+			"зупиняється на найкоротшому з них.",
+	)
+
+	EnumerateFunction = types.MustOverload(
+		newFunctionalBuiltin(
+			"пронумерувати",
+			[]types.FunctionParameter{newAnyParameter("елементи", false)},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Enumerate(state, args[0], 0)
+			},
+			types.Iterator,
+			"Повертає ітератор пар [номер, елемент] з номерами від 0.",
+		),
+		newFunctionalBuiltin(
+			"пронумерувати",
+			[]types.FunctionParameter{
+				newAnyParameter("елементи", false),
+				{
+					Type:       types.Integer,
+					Name:       "початок",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Enumerate(state, args[0], args[1].(types.IntegerInstance).Value)
+			},
+			types.Iterator,
+			"Повертає ітератор пар [номер, елемент] з номерами від заданого початку.",
+		),
+	)
+
+	AnyFunction = newFunctionalBuiltin(
+		"будь_який",
+		[]types.FunctionParameter{newAnyParameter("елементи", false)},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			result, err := types.AnyOf(state, args[0], false)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewBoolInstance(result), nil
+		},
+		types.Bool,
+		"Перевіряє, чи хоча б один елемент є істинним.",
+	)
+
+	AllFunction = newFunctionalBuiltin(
+		"усі",
+		[]types.FunctionParameter{newAnyParameter("елементи", false)},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			result, err := types.AnyOf(state, args[0], true)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewBoolInstance(result), nil
+		},
+		types.Bool,
+		"Перевіряє, чи всі елементи є істинними.",
+	)
+
+	MinFunction = newExtremeFunction(
+		"мін",
+		common.LessOp,
+		"Повертає перший з найменших елементів.",
+		"Повертає перший елемент з найменшим значенням функції ключ.",
+	)
+	MaxFunction = newExtremeFunction(
+		"макс",
+		common.GreaterOp,
+		"Повертає перший з найбільших елементів.",
+		"Повертає перший елемент з найбільшим значенням функції ключ.",
+	)
+
+	SumFunction = types.MustOverload(
+		newFunctionalBuiltin(
+			"сума",
+			[]types.FunctionParameter{newAnyParameter("елементи", false)},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Sum(state, args[0], types.NewIntegerInstance(0))
+			},
+			types.Any,
+			"Повертає суму елементів.",
+		),
+		newFunctionalBuiltin(
+			"сума",
+			[]types.FunctionParameter{newAnyParameter("елементи", false), newAnyParameter("початок", false)},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.Sum(state, args[0], args[1])
+			},
+			types.Any,
+			"Повертає суму початкового значення й елементів.",
+		),
+	)
+
+	SortedFunction = types.MustOverload(
+		newSortedFunction(
+			[]types.FunctionParameter{},
+			func([]common.Value) (common.Value, bool) {
+				return types.NewNilInstance(), false
+			},
+			"Повертає новий список з елементів, відсортованих за зростанням.",
+		),
+		newSortedFunction(
+			[]types.FunctionParameter{newReverseParameter()},
+			func(args []common.Value) (common.Value, bool) {
+				return types.NewNilInstance(), args[0].(types.BoolInstance).Value
+			},
+			"Повертає новий список з елементів, відсортованих за зростанням або, якщо зворотний, за спаданням.",
+		),
+		newSortedFunction(
+			[]types.FunctionParameter{newAnyParameter("ключ", false)},
+			func(args []common.Value) (common.Value, bool) {
+				return args[0], false
+			},
+			"Повертає новий список з елементів, відсортованих за значеннями функції ключ.",
+		),
+		newSortedFunction(
+			[]types.FunctionParameter{newAnyParameter("ключ", false), newReverseParameter()},
+			func(args []common.Value) (common.Value, bool) {
+				return args[0], args[1].(types.BoolInstance).Value
+			},
+			"Повертає новий список з елементів, відсортованих за значеннями функції ключ, за спаданням, якщо зворотний.",
+		),
+	)
+}
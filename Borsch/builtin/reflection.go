@@ -0,0 +1,171 @@
+package builtin
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+var (
+	IsInstanceFunction      *types.FunctionInstance
+	IsSubclassFunction      *types.FunctionInstance
+	HasAttributeFunction    *types.FunctionInstance
+	GetAttributeFunction    *types.FunctionInstance
+	SetAttributeFunction    *types.FunctionInstance
+	DeleteAttributeFunction *types.FunctionInstance
+	AttributesFunction      *types.FunctionInstance
+	ParametersFunction      *types.FunctionInstance
+	ReturnTypesFunction     *types.FunctionInstance
+	OverloadsFunction       *types.FunctionInstance
+)
+
+func newNameParameter() types.FunctionParameter {
+	return types.FunctionParameter{
+		Type:       types.String,
+		Name:       "назва",
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
+
+func newFunctionParameter() types.FunctionParameter {
+	return types.FunctionParameter{
+		Type:       types.Function,
+		Name:       "функція",
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
+
+func newCheckFunction(name string, check func(common.Value, common.Value) (bool, error), doc string) *types.FunctionInstance {
+	return newFunctionalBuiltin(
+		name,
+		[]types.FunctionParameter{newAnyParameter("значення", false), newAnyParameter("типи", false)},
+		func(_ common.State, args []common.Value) (common.Value, error) {
+			result, err := check(args[0], args[1])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewBoolInstance(result), nil
+		},
+		types.Bool,
+		doc,
+	)
+}
+
+func initReflectionRuntime() {
+	IsInstanceFunction = newCheckFunction(
+		"є_екземпляром", types.IsInstance, "Перевіряє, чи значення є екземпляром типу або одного з типів списку.",
+	)
+	IsSubclassFunction = newCheckFunction(
+		"є_підкласом", types.IsSubclass, "Перевіряє, чи тип є підкласом типу або одного з типів списку.",
+	)
+
+	HasAttributeFunction = newFunctionalBuiltin(
+		"має_атрибут",
+		[]types.FunctionParameter{newAnyParameter("об'єкт", false), newNameParameter()},
+		func(_ common.State, args []common.Value) (common.Value, error) {
+			return types.NewBoolInstance(args[0].HasAttribute(args[1].(types.StringInstance).Value)), nil
+		},
+		types.Bool,
+		"Перевіряє, чи об'єкт має атрибут з назвою.",
+	)
+
+	GetAttributeFunction = types.MustOverload(
+		newFunctionalBuiltin(
+			"отримати_атрибут",
+			[]types.FunctionParameter{newAnyParameter("об'єкт", false), newNameParameter()},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return args[0].GetAttribute(state, args[1].(types.StringInstance).Value)
+			},
+			types.Any,
+			"Повертає атрибут об'єкта з назвою.",
+		),
+		newFunctionalBuiltin(
+			"отримати_атрибут",
+			[]types.FunctionParameter{
+				newAnyParameter("об'єкт", false),
+				newNameParameter(),
+				newAnyParameter("за_замовчуванням", false),
+			},
+			func(state common.State, args []common.Value) (common.Value, error) {
+				return types.GetAttributeOrDefault(state, args[0], args[1].(types.StringInstance).Value, args[2])
+			},
+			types.Any,
+			"Повертає атрибут об'єкта з назвою або значення за замовчуванням, якщо такого атрибута немає.",
+		),
+	)
+
+	SetAttributeFunction = newFunctionalBuiltin(
+		"встановити_атрибут",
+		[]types.FunctionParameter{
+			newAnyParameter("об'єкт", false),
+			newNameParameter(),
+			newAnyParameter("значення", false),
+		},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			err := args[0].SetAttribute(state, args[1].(types.StringInstance).Value, args[2])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewNilInstance(), nil
+		},
+		types.Nil,
+		"Встановлює значення атрибута об'єкта з назвою.",
+	)
+
+	DeleteAttributeFunction = newFunctionalBuiltin(
+		"вилучити_атрибут",
+		[]types.FunctionParameter{newAnyParameter("об'єкт", false), newNameParameter()},
+		func(_ common.State, args []common.Value) (common.Value, error) {
+			if err := types.DeleteAttribute(args[0], args[1].(types.StringInstance).Value); err != nil {
+				return nil, err
+			}
+
+			return types.NewNilInstance(), nil
+		},
+		types.Nil,
+		"Вилучає атрибут об'єкта з назвою.",
+	)
+
+	AttributesFunction = newFunctionalBuiltin(
+		"атрибути",
+		[]types.FunctionParameter{newAnyParameter("об'єкт", false)},
+		func(_ common.State, args []common.Value) (common.Value, error) {
+			return types.Attributes(args[0]), nil
+		},
+		types.List,
+		"Повертає відсортовані назви атрибутів, доступних через об'єкт.",
+	)
+
+	ParametersFunction = newFunctionalBuiltin(
+		"параметри",
+		[]types.FunctionParameter{newFunctionParameter()},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			return types.ParametersOf(state, args[0].(*types.FunctionInstance))
+		},
+		types.List,
+		"Повертає список словників з описом параметрів функції.",
+	)
+
+	ReturnTypesFunction = newFunctionalBuiltin(
+		"типи_повернення",
+		[]types.FunctionParameter{newFunctionParameter()},
+		func(state common.State, args []common.Value) (common.Value, error) {
+			return types.ReturnTypesOf(state, args[0].(*types.FunctionInstance))
+		},
+		types.List,
+		"Повертає список словників з описом типів, які повертає функція.",
+	)
+
+	OverloadsFunction = newFunctionalBuiltin(
+		"перевантаження",
+		[]types.FunctionParameter{newFunctionParameter()},
+		func(_ common.State, args []common.Value) (common.Value, error) {
+			return types.OverloadsOf(args[0].(*types.FunctionInstance)), nil
+		},
+		types.List,
+		"Повертає варіанти перевантаженої функції або список із самою функцією.",
+	)
+}
@@ -9,24 +9,28 @@ import (
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/cli/build"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
 var (
-	PrintFunction     *types.FunctionInstance
-	PrintLineFunction *types.FunctionInstance
-	InputFunction     *types.FunctionInstance
-	PanicFunction     *types.FunctionInstance
-	EnvFunction       *types.FunctionInstance
-	AssertFunction    *types.FunctionInstance
-	CopyrightFunction *types.FunctionInstance
-	LicenceFunction   *types.FunctionInstance
-	HelpFunction      *types.FunctionInstance
-	ExitFunction      *types.FunctionInstance
-	ImportFunction    *types.FunctionInstance
-	LengthFunction    *types.FunctionInstance
-	AddToListFunction *types.FunctionInstance
-	DeepCopyFunction  *types.FunctionInstance
-	TypeFunction      *types.FunctionInstance
+	PrintFunction       *types.FunctionInstance
+	PrintLineFunction   *types.FunctionInstance
+	InputFunction       *types.FunctionInstance
+	PanicFunction       *types.FunctionInstance
+	EnvFunction         *types.FunctionInstance
+	AssertFunction      *types.FunctionInstance
+	CopyrightFunction   *types.FunctionInstance
+	LicenceFunction     *types.FunctionInstance
+	HelpFunction        *types.FunctionInstance
+	ExitFunction        *types.FunctionInstance
+	ImportFunction      *types.FunctionInstance
+	LengthFunction      *types.FunctionInstance
+	AddToListFunction   *types.FunctionInstance
+	DeepCopyFunction    *types.FunctionInstance
+	ShallowCopyFunction *types.FunctionInstance
+	HashFunction        *types.FunctionInstance
+	TypeFunction        *types.FunctionInstance
+	SuperFunction       *types.FunctionInstance
 )
 
 func initRuntime() {
@@ -380,7 +384,7 @@ func initRuntime() {
 			},
 		},
 		func(_ common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
-			list := (*args)[0].(types.ListInstance)
+			list := (*args)[0].(*types.ListInstance)
 			values := (*args)[1:]
 			for _, value := range values {
 				list.Values = append(list.Values, value)
@@ -409,8 +413,32 @@ func initRuntime() {
 				IsNullable: false,
 			},
 		},
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return types.DeepCopy(state, (*args)[0])
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       types.Any,
+				IsNullable: false,
+			},
+		},
+		false,
+		types.BuiltinPackage,
+		"Повертає глибоку копію значення, в якій вкладені контейнери й об'єкти також скопійовано.",
+	)
+
+	ShallowCopyFunction = types.NewFunctionInstance(
+		"поверхнева_копія",
+		[]types.FunctionParameter{
+			{
+				Type:       types.Any,
+				Name:       "значення",
+				IsVariadic: false,
+				IsNullable: false,
+			},
+		},
 		func(_ common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
-			return deepCopy((*args)[0])
+			return types.ShallowCopy((*args)[0]), nil
 		},
 		[]types.FunctionReturnType{
 			{
@@ -423,6 +451,35 @@ func initRuntime() {
 		"", // TODO: add doc
 	)
 
+	HashFunction = types.NewFunctionInstance(
+		"хеш",
+		[]types.FunctionParameter{
+			{
+				Type:       types.Any,
+				Name:       "значення",
+				IsVariadic: false,
+				IsNullable: true,
+			},
+		},
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			hash, err := types.Hash(state, (*args)[0])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewIntegerInstance(int64(hash)), nil
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       types.Integer,
+				IsNullable: false,
+			},
+		},
+		false,
+		types.BuiltinPackage,
+		"Повертає хеш значення, рівні значення мають однаковий хеш.",
+	)
+
 	TypeFunction = types.NewFunctionInstance(
 		"тип",
 		[]types.FunctionParameter{
@@ -446,4 +503,26 @@ func initRuntime() {
 		types.BuiltinPackage,
 		"", // TODO: add doc
 	)
+
+	SuperFunction = types.NewFunctionInstance(
+		common.SuperTypeName,
+		[]types.FunctionParameter{},
+		func(state common.State, _ *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			super, err := state.GetContext().GetVar(common.SuperAttributeName)
+			if err != nil {
+				return nil, util.RuntimeError("'батько()' можна викликати лише у методі класу")
+			}
+
+			return super, nil
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       types.Super,
+				IsNullable: false,
+			},
+		},
+		false,
+		types.BuiltinPackage,
+		"Повертає об'єкт, через який метод викликає методи базових класів.",
+	)
 }
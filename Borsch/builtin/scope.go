@@ -10,6 +10,9 @@ var BuiltinScope map[string]common.Value
 
 func init() {
 	initRuntime()
+	initFunctionalRuntime()
+	initReflectionRuntime()
+	initConversionRuntime()
 	BuiltinScope = map[string]common.Value{
 
 		// I/O
@@ -38,15 +41,56 @@ func init() {
 		// "функція": types.Function,
 		"цілий":     types.Integer,
 		"довільний": types.Any,
+		"генератор": types.Generator,
+		"ітератор":  types.Iterator,
+		"діапазон":  types.Range,
+
+		ParseNumberFunction.Name: ParseNumberFunction,
+		FormatFunction.Name:      FormatFunction,
+
+		// Operators
+		"нереалізований": types.NotImplemented,
+		"нереалізовано":  types.NewNotImplementedInstance(),
 
 		// Utilities
-		"довжина":   LengthFunction,
-		"додати":    AddToListFunction,
-		"копіювати": DeepCopyFunction,
-		"тип":       TypeFunction,
+		"довжина":          LengthFunction,
+		"додати":           AddToListFunction,
+		"копіювати":        DeepCopyFunction,
+		"поверхнева_копія": ShallowCopyFunction,
+		"тип":              TypeFunction,
+		"хеш":              HashFunction,
+		"батько":           SuperFunction,
+
+		// Functional
+		MapFunction.Name:       MapFunction,
+		FilterFunction.Name:    FilterFunction,
+		ReduceFunction.Name:    ReduceFunction,
+		ZipFunction.Name:       ZipFunction,
+		EnumerateFunction.Name: EnumerateFunction,
+		AnyFunction.Name:       AnyFunction,
+		AllFunction.Name:       AllFunction,
+		MinFunction.Name:       MinFunction,
+		MaxFunction.Name:       MaxFunction,
+		SumFunction.Name:       SumFunction,
+		SortedFunction.Name:    SortedFunction,
+
+		// Reflection
+		IsInstanceFunction.Name:      IsInstanceFunction,
+		IsSubclassFunction.Name:      IsSubclassFunction,
+		HasAttributeFunction.Name:    HasAttributeFunction,
+		GetAttributeFunction.Name:    GetAttributeFunction,
+		SetAttributeFunction.Name:    SetAttributeFunction,
+		DeleteAttributeFunction.Name: DeleteAttributeFunction,
+		AttributesFunction.Name:      AttributesFunction,
+		ParametersFunction.Name:      ParametersFunction,
+		ReturnTypesFunction.Name:     ReturnTypesFunction,
+		OverloadsFunction.Name:       OverloadsFunction,
 
 		// Classes
-		std.ErrorClass.GetTypeName(): std.ErrorClass,
+		std.ErrorClass.GetName(): std.ErrorClass,
+
+		// Packages
+		std.CollationPackage.Name: std.CollationPackage,
 	}
 
 	types.BuiltinPackage.SetAttributes(BuiltinScope)
@@ -0,0 +1,104 @@
+package std
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+var CollationPackage *types.PackageInstance = nil
+
+// newCollationKeyFunction creates a function which is passed as a key
+// to sorting to order strings by the collation regardless of the one
+// set for comparison operators.
+func newCollationKeyFunction(collation types.Collation, parent *types.PackageInstance) *types.FunctionInstance {
+	return types.NewFunctionInstance(
+		collation.Name(),
+		[]types.FunctionParameter{
+			{
+				Type:       types.String,
+				Name:       "рядок",
+				IsVariadic: false,
+				IsNullable: false,
+			},
+		},
+		func(_ common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			key := types.NewListInstance()
+			for _, weight := range collation.Key((*args)[0].(types.StringInstance).Value) {
+				key.Values = append(key.Values, types.NewIntegerInstance(weight))
+			}
+
+			return key, nil
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       types.List,
+				IsNullable: false,
+			},
+		},
+		false,
+		parent,
+		"Повертає ключ, за яким рядки сортуються в режимі '"+collation.Name()+"'.",
+	)
+}
+
+func newCollationPackage() *types.PackageInstance {
+	pkg := types.NewPackageInstance(nil, "упорядкування", nil, nil)
+	attributes := map[string]common.Value{
+		"встановити": types.NewFunctionInstance(
+			"встановити",
+			[]types.FunctionParameter{
+				{
+					Type:       types.String,
+					Name:       "режим",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+				collation, err := types.CollationByName((*args)[0].(types.StringInstance).Value)
+				if err != nil {
+					return nil, err
+				}
+
+				state.GetCurrentPackage().(*types.PackageInstance).Collation = collation
+				return types.NewNilInstance(), nil
+			},
+			[]types.FunctionReturnType{
+				{
+					Type:       types.Nil,
+					IsNullable: false,
+				},
+			},
+			false,
+			pkg,
+			"Встановлює режим, в якому оператори порівняння впорядковують рядки в поточному пакеті.",
+		),
+		"поточний": types.NewFunctionInstance(
+			"поточний",
+			[]types.FunctionParameter{},
+			func(state common.State, _ *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+				return types.NewStringInstance(types.CollationOf(state).Name()), nil
+			},
+			[]types.FunctionReturnType{
+				{
+					Type:       types.String,
+					IsNullable: false,
+				},
+			},
+			false,
+			pkg,
+			"Повертає назву режиму, в якому оператори порівняння впорядковують рядки в поточному пакеті.",
+		),
+	}
+
+	for _, collation := range []types.Collation{
+		types.CodePointCollation,
+		types.UkrainianCollation,
+		types.CaseInsensitiveCollation,
+	} {
+		attributes[collation.Name()] = newCollationKeyFunction(collation, pkg)
+	}
+
+	pkg.SetAttributes(attributes)
+	return pkg
+}
@@ -7,6 +7,10 @@ import (
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 )
 
+// messageAttributeName is the attribute which keeps the message of
+// instances of classes derived from 'Помилка'.
+const messageAttributeName = "__повідомлення__"
+
 type ErrorInstance struct {
 	types.ClassInstance
 	message string
@@ -32,16 +36,78 @@ func (t ErrorInstance) AsBool(common.State) (bool, error) {
 }
 
 func compareErrors(_ common.State, _ common.Operator, self common.Value, other common.Value) (int, error) {
-	if _, ok := other.(*ErrorInstance); ok {
-		if self == other {
-			return 0, nil
-		}
+	if self == other {
+		return 0, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
 	return -2, nil
 }
 
+// getMessage returns the message of the built-in error or of the
+// instance of a class derived from 'Помилка', which keeps it in
+// the attribute.
+func getMessage(state common.State, value common.Value) (string, error) {
+	if self, ok := value.(*ErrorInstance); ok {
+		return self.message, nil
+	}
+
+	if !value.HasAttribute(messageAttributeName) {
+		return "", nil
+	}
+
+	message, err := value.GetAttribute(state, messageAttributeName)
+	if err != nil {
+		return "", err
+	}
+
+	return message.String(state)
+}
+
+func setMessage(state common.State, value common.Value, message string) error {
+	if self, ok := value.(*ErrorInstance); ok {
+		self.message = message
+		return nil
+	}
+
+	return value.SetAttribute(state, messageAttributeName, types.NewStringInstance(message))
+}
+
+func newErrorStringMethod(
+	name string,
+	handler func(state common.State, self common.Value) (string, error),
+	doc string,
+) *types.FunctionInstance {
+	return types.NewFunctionInstance(
+		name,
+		[]types.FunctionParameter{
+			{
+				Type:       ErrorClass,
+				Name:       "я",
+				IsVariadic: false,
+				IsNullable: false,
+			},
+		},
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			result, err := handler(state, (*args)[0])
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewStringInstance(result), nil
+		},
+		[]types.FunctionReturnType{
+			{
+				Type:       types.String,
+				IsNullable: false,
+			},
+		},
+		true,
+		nil,
+		doc,
+	)
+}
+
 func newErrorClass() *types.Class {
 	initAttributes := func(attrs *map[string]common.Value) {
 		*attrs = types.MergeAttributes(
@@ -64,19 +130,23 @@ func newErrorClass() *types.Class {
 						},
 					},
 					func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
-						rawParts := (*args)[1:]
-						self := (*args)[0].(*ErrorInstance)
-						for _, rawPart := range rawParts {
+						// 'я' is an instance of a derived class if the
+						// constructor is called through 'батько()'
+						message, err := getMessage(state, (*args)[0])
+						if err != nil {
+							return nil, err
+						}
+
+						for _, rawPart := range (*args)[1:] {
 							part, err := rawPart.String(state)
 							if err != nil {
 								return nil, err
 							}
 
-							self.message += part
+							message += part
 						}
 
-						(*args)[0] = self
-						return types.NewNilInstance(), nil
+						return types.NewNilInstance(), setMessage(state, (*args)[0], message)
 					},
 					[]types.FunctionReturnType{
 						{
@@ -88,33 +158,23 @@ func newErrorClass() *types.Class {
 					nil,
 					"",
 				),
-				"повідомлення": types.NewFunctionInstance(
-					"повідомлення",
-					[]types.FunctionParameter{
-						{
-							Type:       ErrorClass,
-							Name:       "я",
-							IsVariadic: false,
-							IsNullable: false,
-						},
-					},
-					func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
-						msg, err := (*args)[0].String(state)
+				"повідомлення": newErrorStringMethod("повідомлення", getMessage, "Повертає повідомлення помилки."),
+
+				// derived classes are printed as the built-in error
+				common.StringOperatorName: newErrorStringMethod(
+					common.StringOperatorName, getMessage, "Повертає повідомлення помилки.",
+				),
+				common.RepresentationOperatorName: newErrorStringMethod(
+					common.RepresentationOperatorName,
+					func(state common.State, self common.Value) (string, error) {
+						message, err := getMessage(state, self)
 						if err != nil {
-							return nil, err
+							return "", err
 						}
 
-						return types.NewStringInstance(msg), nil
+						return fmt.Sprintf("%s(\"%s\")", self.GetTypeName(), message), nil
 					},
-					[]types.FunctionReturnType{
-						{
-							Type:       types.String,
-							IsNullable: false,
-						},
-					},
-					true,
-					nil,
-					"",
+					"Повертає назву типу помилки разом з її повідомленням.",
 				),
 			},
 			types.MakeLogicalOperators(ErrorClass),
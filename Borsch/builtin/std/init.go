@@ -10,4 +10,6 @@ func Init() {
 	if !ErrorClass.IsValid() {
 		panic("ErrorClass is not valid")
 	}
+
+	CollationPackage = newCollationPackage()
 }
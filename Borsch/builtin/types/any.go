@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// newAnyClass creates the type which values of all types match, it is
+// compared by identity and has no instances of its own.
+func newAnyClass() *Class {
+	return &Class{
+		Name:       common.AnyTypeName,
+		attributes: map[string]common.Value{},
+		IsFinal:    true,
+		Bases:      []*Class{},
+		Parent:     BuiltinPackage,
+		GetEmptyInstance: func() (common.Value, error) {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("неможливо створити об'єкт типу '%s'", common.AnyTypeName),
+			)
+		},
+	}
+}
@@ -60,7 +60,7 @@ func compareBooleans(state common.State, op common.Operator, self common.Value,
 			return 0, nil
 		}
 	default:
-		return 0, util.OperatorNotSupportedError(op, left.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
@@ -134,7 +134,7 @@ func newBoolClass() *Class {
 								),
 							), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -167,7 +167,7 @@ func newBoolClass() *Class {
 						case RealInstance:
 							return NewRealInstance(boolToFloat64(self.Value) * o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -188,7 +188,7 @@ func newBoolClass() *Class {
 								return NewRealInstance(boolToFloat64(self.Value) / o.Value), nil
 							}
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 
 						return nil, errors.New("ділення на нуль")
@@ -207,7 +207,7 @@ func newBoolClass() *Class {
 								return NewIntegerInstance(boolToInt64(self.Value) % o.Value), nil
 							}
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 
 						return nil, errors.New("ділення за модулем на нуль")
@@ -224,7 +224,7 @@ func newBoolClass() *Class {
 						case RealInstance:
 							return NewRealInstance(boolToFloat64(self.Value) + o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -239,7 +239,7 @@ func newBoolClass() *Class {
 						case RealInstance:
 							return NewRealInstance(boolToFloat64(self.Value) - o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -254,7 +254,7 @@ func newBoolClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(boolToInt64(self.Value) << o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -269,7 +269,7 @@ func newBoolClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(boolToInt64(self.Value) >> o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -282,7 +282,7 @@ func newBoolClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(boolToInt64(self.Value) & o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -295,7 +295,7 @@ func newBoolClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(boolToInt64(self.Value) ^ o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -308,7 +308,7 @@ func newBoolClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(boolToInt64(self.Value) | o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
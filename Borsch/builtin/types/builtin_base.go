@@ -13,7 +13,7 @@ type BuiltinInstance struct {
 	ClassInstance
 }
 
-func (i BuiltinInstance) SetAttribute(name string, _ common.Value) error {
+func (i BuiltinInstance) SetAttribute(_ common.State, name string, _ common.Value) error {
 	if i.HasAttribute(name) {
 		return util.AttributeIsReadOnlyError(i.GetTypeName(), name)
 	}
@@ -24,3 +24,9 @@ func (i BuiltinInstance) SetAttribute(name string, _ common.Value) error {
 func (i BuiltinInstance) Call(common.State, *[]common.Value, *map[string]common.Value) (common.Value, error) {
 	return nil, util.ObjectIsNotCallable("", i.GetTypeName())
 }
+
+// GetAttribute is defined for the value, built-in instances are passed
+// by value and have no properties which need the original object.
+func (i BuiltinInstance) GetAttribute(state common.State, name string) (common.Value, error) {
+	return i.ClassInstance.GetAttribute(state, name)
+}
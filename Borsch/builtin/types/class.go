@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
@@ -13,9 +15,26 @@ type Class struct {
 
 	IsFinal bool
 
+	// IsAbstract classes can not be instantiated and may contain
+	// abstract methods, IsInterface ones contain only them.
+	IsAbstract  bool
+	IsInterface bool
+
+	enumMembers []*EnumMemberInstance
+	frozen      map[string]bool
+
+	// TypeParameters of a generic class, instances remember
+	// types they are bound to.
+	TypeParameters  []*Class
+	isTypeParameter bool
+	unionMembers    []TypeArgument
+
 	Class *Class
 	Bases []*Class
 
+	// mro is the method resolution order, see Linearize
+	mro []*Class
+
 	Parent common.Value
 
 	AttrInitializer  func(*map[string]common.Value)
@@ -145,25 +164,22 @@ func (c *Class) GetOperator(name string) (common.Value, error) {
 		return nil, util.AttributeNotFoundError(c.GetTypeName(), name)
 	}
 
-	return c.GetClass().GetAttribute(name)
+	return c.GetClass().getAttribute(name)
 }
 
-func (c *Class) GetAttribute(name string) (common.Value, error) {
-	if c.attributes != nil {
-		if val, ok := c.attributes[name]; ok {
-			return val, nil
-		}
-	}
+func (c *Class) GetAttribute(_ common.State, name string) (common.Value, error) {
+	return c.getAttribute(name)
+}
 
-	basesLastIdx := len(c.Bases) - 1
-	for i := basesLastIdx; i >= 0; i-- {
-		if attr, err := c.Bases[i].GetAttribute(name); err == nil {
-			return attr, nil
-		}
+// getAttribute looks for the attribute in the class itself, then in
+// its bases in the method resolution order.
+func (c *Class) getAttribute(name string) (common.Value, error) {
+	if attr, ok := c.lookupAttribute(c.MRO(), name); ok {
+		return attr, nil
 	}
 
 	if !c.isType() {
-		if attr, err := c.GetClass().GetAttribute(name); err == nil {
+		if attr, err := c.GetClass().getAttribute(name); err == nil {
 			return attr, nil
 		}
 	}
@@ -171,7 +187,11 @@ func (c *Class) GetAttribute(name string) (common.Value, error) {
 	return nil, util.AttributeNotFoundError(c.GetName(), name)
 }
 
-func (c *Class) SetAttribute(name string, newValue common.Value) error {
+func (c *Class) SetAttribute(_ common.State, name string, newValue common.Value) error {
+	if c.IsFrozenAttribute(name) {
+		return util.AttributeIsReadOnlyError(c.GetName(), name)
+	}
+
 	if c.isType() {
 		if c.HasAttribute(name) {
 			return util.AttributeIsReadOnlyError(c.GetTypeName(), name)
@@ -200,8 +220,19 @@ func (c *Class) SetAttribute(name string, newValue common.Value) error {
 	return nil
 }
 
+// lookupAttribute looks for the attribute defined in one of the classes.
+func (c *Class) lookupAttribute(classes []*Class, name string) (common.Value, bool) {
+	for _, class := range classes {
+		if attr, ok := class.attributes[name]; ok {
+			return attr, true
+		}
+	}
+
+	return nil, false
+}
+
 func (c *Class) HasAttribute(name string) bool {
-	if _, ok := c.attributes[name]; !ok {
+	if _, ok := c.lookupAttribute(c.MRO(), name); !ok {
 		if !c.isType() {
 			return c.GetClass().HasAttribute(name)
 		}
@@ -212,6 +243,42 @@ func (c *Class) HasAttribute(name string) bool {
 	return true
 }
 
+// attributeNames collects names of attributes which are found by
+// getAttribute.
+func (c *Class) attributeNames(names map[string]bool) {
+	for _, class := range c.MRO() {
+		for name := range class.attributes {
+			names[name] = true
+		}
+	}
+
+	if !c.isType() {
+		c.GetClass().attributeNames(names)
+	}
+}
+
+// FreezeAttributes makes attributes read-only both for the class and
+// its instances.
+func (c *Class) FreezeAttributes(names ...string) {
+	if c.frozen == nil {
+		c.frozen = map[string]bool{}
+	}
+
+	for _, name := range names {
+		c.frozen[name] = true
+	}
+}
+
+func (c *Class) IsFrozenAttribute(name string) bool {
+	for _, class := range c.MRO() {
+		if class.frozen[name] {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *Class) SetAttributes(attrs map[string]common.Value) {
 	c.attributes = attrs
 	if c.attributes == nil {
@@ -219,13 +286,37 @@ func (c *Class) SetAttributes(attrs map[string]common.Value) {
 	}
 }
 
+// InheritOverloads merges each method of the class with overloads of
+// the method with the same name found in bases, so a method does not
+// hide inherited overloads which accept other arguments. Constructors
+// are not merged, since they initialize instances of different classes.
+func (c *Class) InheritOverloads() {
+	for name, attribute := range c.attributes {
+		method, ok := attribute.(*FunctionInstance)
+		if !ok || name == common.ConstructorName {
+			continue
+		}
+
+		inherited, ok := c.lookupAttribute(c.MRO()[1:], name)
+		if !ok {
+			continue
+		}
+
+		if inheritedMethod, ok := inherited.(*FunctionInstance); ok {
+			c.attributes[name] = method.Inherit(inheritedMethod)
+		}
+	}
+}
+
 func (c *Class) EqualsTo(other common.Value) bool {
 	cls, ok := other.(*Class)
 	return ok && cls == c
 }
 
+// HasBase checks if the class is derived from cls directly or through
+// other bases.
 func (c *Class) HasBase(cls *Class) bool {
-	for _, base := range c.Bases {
+	for _, base := range c.MRO()[1:] {
 		if cls == base {
 			return true
 		}
@@ -234,6 +325,154 @@ func (c *Class) HasBase(cls *Class) bool {
 	return false
 }
 
+// MRO returns the class and all of its bases in the order attributes
+// are looked up in.
+func (c *Class) MRO() []*Class {
+	if c.mro == nil {
+		if err := c.Linearize(); err != nil {
+			// the hierarchy is checked when the class is defined
+			c.mro = []*Class{c}
+		}
+	}
+
+	return c.mro
+}
+
+// Linearize computes the method resolution order using C3 linearization:
+// each class precedes its bases, and bases keep the order they are listed
+// in the class definition. Returns an error if there is no such order.
+func (c *Class) Linearize() error {
+	var sequences [][]*Class
+	for _, base := range c.Bases {
+		sequences = append(sequences, append([]*Class{}, base.MRO()...))
+	}
+
+	sequences = append(sequences, append([]*Class{}, c.Bases...))
+	mro := []*Class{c}
+	for {
+		var remaining [][]*Class
+		for _, sequence := range sequences {
+			if len(sequence) != 0 {
+				remaining = append(remaining, sequence)
+			}
+		}
+
+		if len(remaining) == 0 {
+			c.mro = mro
+			return nil
+		}
+
+		sequences = remaining
+		var head *Class
+		for _, sequence := range sequences {
+			if !isInTailOf(sequence[0], sequences) {
+				head = sequence[0]
+				break
+			}
+		}
+
+		if head == nil {
+			var names []string
+			for _, base := range c.Bases {
+				names = append(names, base.GetName())
+			}
+
+			return util.RuntimeError(
+				fmt.Sprintf(
+					"неможливо визначити порядок розв'язання методів класу '%s' для базових класів %s",
+					c.GetName(), strings.Join(names, ", "),
+				),
+			)
+		}
+
+		mro = append(mro, head)
+		for idx, sequence := range sequences {
+			if sequence[0] == head {
+				sequences[idx] = sequence[1:]
+			}
+		}
+	}
+}
+
+func isInTailOf(class *Class, sequences [][]*Class) bool {
+	for _, sequence := range sequences {
+		for _, other := range sequence[1:] {
+			if other == class {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CheckImplementation checks that the class implements abstract methods
+// of its bases with the same signatures. Abstract classes are allowed
+// to leave some of them not implemented.
+func (c *Class) CheckImplementation() error {
+	if !c.IsAbstract {
+		for _, name := range c.getAbstractMethodNames() {
+			return util.RuntimeError(
+				fmt.Sprintf(
+					"клас '%s' містить абстрактний метод '%s', тому має бути абстрактним",
+					c.GetName(), name,
+				),
+			)
+		}
+	}
+
+	return c.checkAbstractMethodsOf(c.Bases)
+}
+
+func (c *Class) checkAbstractMethodsOf(bases []*Class) error {
+	for _, base := range bases {
+		for _, name := range base.getAbstractMethodNames() {
+			method := base.attributes[name].(*FunctionInstance)
+			attribute, _ := c.getAttribute(name)
+			implementation, ok := attribute.(*FunctionInstance)
+			if !ok || implementation.IsAbstract {
+				if c.IsAbstract {
+					continue
+				}
+
+				return util.RuntimeError(
+					fmt.Sprintf(
+						"клас '%s' має реалізувати метод '%s' з '%s'",
+						c.GetName(), name, base.GetName(),
+					),
+				)
+			}
+
+			if !implementation.HasSignatureOf(method) {
+				return util.RuntimeError(
+					fmt.Sprintf(
+						"сигнатура методу '%s' класу '%s' не відповідає сигнатурі з '%s'",
+						name, c.GetName(), base.GetName(),
+					),
+				)
+			}
+		}
+
+		if err := c.checkAbstractMethodsOf(base.Bases); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Class) getAbstractMethodNames() []string {
+	var names []string
+	for name, attribute := range c.attributes {
+		if method, ok := attribute.(*FunctionInstance); ok && method.IsAbstract {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
 // Call executes common.ConstructorName operator if it exists in attributes.
 func (c *Class) Call(state common.State, args *[]common.Value, kwargs *map[string]common.Value) (common.Value, error) {
 	operator, err := c.GetOperator(common.ConstructorName)
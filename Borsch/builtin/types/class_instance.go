@@ -11,6 +11,10 @@ type ClassInstance struct {
 	class      *Class
 	attributes map[string]common.Value
 	address    string
+
+	// typeArguments are types which type parameters of generic
+	// classes are bound to for the instance.
+	typeArguments map[*Class]*Class
 }
 
 func NewClassInstance(class *Class, attributes map[string]common.Value) *ClassInstance {
@@ -24,6 +28,10 @@ func NewClassInstance(class *Class, attributes map[string]common.Value) *ClassIn
 		address:    "",
 	}
 
+	if class != nil && class.isGeneric() {
+		instance.typeArguments = map[*Class]*Class{}
+	}
+
 	instance.address = fmt.Sprintf("%p", instance)
 	return instance
 }
@@ -36,7 +44,7 @@ func (i ClassInstance) GetAddress() string {
 	return i.address
 }
 
-func (i ClassInstance) String(state common.State) (string, error) {
+func (i *ClassInstance) String(state common.State) (string, error) {
 	if operator, err := i.GetOperator(common.StringOperatorName); err == nil {
 		result, err := CallAttribute(state, i, operator, common.StringOperatorName, nil, nil, true)
 		if err != nil {
@@ -49,7 +57,7 @@ func (i ClassInstance) String(state common.State) (string, error) {
 	return fmt.Sprintf("<об'єкт %s з адресою %s>", i.GetTypeName(), i.GetAddress()), nil
 }
 
-func (i ClassInstance) Representation(state common.State) (string, error) {
+func (i *ClassInstance) Representation(state common.State) (string, error) {
 	if operator, err := i.GetOperator(common.RepresentationOperatorName); err == nil {
 		result, err := CallAttribute(state, i, operator, common.RepresentationOperatorName, nil, nil, true)
 		if err != nil {
@@ -72,28 +80,40 @@ func (i ClassInstance) AsBool(common.State) (bool, error) {
 
 func (i ClassInstance) GetOperator(name string) (common.Value, error) {
 	cls := i.GetClass()
-	if cls.attributes != nil {
-		if val, ok := cls.attributes[name]; ok {
-			return val, nil
-		}
+	if val, ok := cls.lookupAttribute(cls.MRO(), name); ok {
+		return val, nil
 	}
 
 	return nil, util.OperatorNotFoundError(i.GetTypeName(), name)
 }
 
-func (i ClassInstance) GetAttribute(name string) (common.Value, error) {
+func (i *ClassInstance) GetAttribute(state common.State, name string) (common.Value, error) {
 	if val, ok := i.attributes[name]; ok {
 		return val, nil
 	}
 
-	if attr, err := i.GetClass().GetAttribute(name); err == nil {
+	if attr, err := i.GetClass().getAttribute(name); err == nil {
+		if property, ok := attr.(*PropertyInstance); ok {
+			return property.Get(state, i)
+		}
+
 		return attr, nil
 	}
 
 	return nil, util.AttributeNotFoundError(i.GetTypeName(), name)
 }
 
-func (i ClassInstance) SetAttribute(name string, newValue common.Value) error {
+func (i *ClassInstance) SetAttribute(state common.State, name string, newValue common.Value) error {
+	if i.GetClass().IsFrozenAttribute(name) {
+		return util.AttributeIsReadOnlyError(i.GetTypeName(), name)
+	}
+
+	if attr, err := i.GetClass().getAttribute(name); err == nil {
+		if property, ok := attr.(*PropertyInstance); ok {
+			return property.Set(state, i, newValue)
+		}
+	}
+
 	if oldValue, ok := i.attributes[name]; ok {
 		oldValueClass := oldValue.(ObjectInstance).GetClass()
 		newValueClass := newValue.(ObjectInstance).GetClass()
@@ -122,7 +142,15 @@ func (i ClassInstance) HasAttribute(name string) bool {
 	return i.GetClass().HasAttribute(name)
 }
 
-func (i ClassInstance) Call(state common.State, args *[]common.Value, kwargs *map[string]common.Value) (
+func (i ClassInstance) attributeNames(names map[string]bool) {
+	for name := range i.attributes {
+		names[name] = true
+	}
+
+	i.GetClass().attributeNames(names)
+}
+
+func (i *ClassInstance) Call(state common.State, args *[]common.Value, kwargs *map[string]common.Value) (
 	common.Value,
 	error,
 ) {
@@ -145,6 +173,13 @@ func (i ClassInstance) Copy() *ClassInstance {
 		instance.attributes[k] = v
 	}
 
+	if i.typeArguments != nil {
+		instance.typeArguments = map[*Class]*Class{}
+		for k, v := range i.typeArguments {
+			instance.typeArguments[k] = v
+		}
+	}
+
 	instance.address = fmt.Sprintf("%p", instance)
 	return instance
 }
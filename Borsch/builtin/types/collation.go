@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// Collation defines the order of strings used by comparison operators
+// and sorting.
+type Collation int
+
+const (
+	// CodePointCollation orders strings by code points of characters.
+	CodePointCollation Collation = iota
+
+	// UkrainianCollation orders letters as in the Ukrainian alphabet,
+	// strings which differ only in case are ordered by code points.
+	UkrainianCollation
+
+	// CaseInsensitiveCollation orders letters as in the Ukrainian
+	// alphabet ignoring their case.
+	CaseInsensitiveCollation
+)
+
+var collationNames = []string{
+	"кодові_точки",
+	"український",
+	"без_регістру",
+}
+
+// DefaultCollation is the order of strings in packages which do not
+// set another one.
+const DefaultCollation = UkrainianCollation
+
+const ukrainianAlphabet = "абвгґдеєжзиіїйклмнопрстуфхцчшщьюя"
+
+var ukrainianLetterIndexes = func() map[rune]uint64 {
+	indexes := map[rune]uint64{}
+	for idx, letter := range []rune(ukrainianAlphabet) {
+		indexes[letter] = uint64(idx)
+	}
+
+	return indexes
+}()
+
+// CollationOf returns the order used by comparison operators of strings
+// in the package which is being evaluated. Each package has its own
+// order, so setting it does not affect imported packages.
+func CollationOf(state common.State) Collation {
+	if pkg, ok := state.GetCurrentPackageOrNil().(*PackageInstance); ok {
+		return pkg.Collation
+	}
+
+	return DefaultCollation
+}
+
+func (c Collation) Name() string {
+	return collationNames[c]
+}
+
+func CollationByName(name string) (Collation, error) {
+	for idx, current := range collationNames {
+		if current == name {
+			return Collation(idx), nil
+		}
+	}
+
+	return 0, util.RuntimeError(
+		fmt.Sprintf(
+			"невідомий режим упорядкування '%s', очікується один з: %s",
+			name, strings.Join(collationNames, ", "),
+		),
+	)
+}
+
+// weight returns the primary weight of the character. Letters of the
+// Ukrainian alphabet follow each other right after 'а', other Cyrillic
+// letters go after them, and the rest of characters keep the order of
+// their code points.
+func weight(r rune) uint64 {
+	lower := unicode.ToLower(r)
+	if idx, ok := ukrainianLetterIndexes[lower]; ok {
+		return uint64('а')<<8 | idx
+	}
+
+	return uint64(lower) << 8
+}
+
+// Key returns a sequence of numbers for the string, keys of strings
+// compared lexicographically give the same result as Compare. -1
+// separates weights of letters from code points which distinguish
+// strings that differ only in case.
+func (c Collation) Key(value string) []int64 {
+	var key []int64
+	if c != CodePointCollation {
+		for _, r := range value {
+			key = append(key, int64(weight(r)))
+		}
+
+		if c == CaseInsensitiveCollation {
+			return key
+		}
+
+		key = append(key, -1)
+	}
+
+	for _, r := range value {
+		key = append(key, int64(r))
+	}
+
+	return key
+}
+
+// Compare returns -1, 0 or 1 if the first string goes before, together
+// with or after the second one.
+func (c Collation) Compare(a, b string) int {
+	if c != CodePointCollation {
+		ar, br := []rune(a), []rune(b)
+		for idx := 0; idx < len(ar) && idx < len(br); idx++ {
+			aw, bw := weight(ar[idx]), weight(br[idx])
+			if aw < bw {
+				return -1
+			}
+
+			if aw > bw {
+				return 1
+			}
+		}
+
+		switch {
+		case len(ar) < len(br):
+			return -1
+		case len(ar) > len(br):
+			return 1
+		case c == CaseInsensitiveCollation:
+			return 0
+		}
+	}
+
+	return strings.Compare(a, b)
+}
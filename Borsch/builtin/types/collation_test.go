@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+)
+
+func compareKeys(a, b []int64) int {
+	for idx := 0; idx < len(a) && idx < len(b); idx++ {
+		if a[idx] < b[idx] {
+			return -1
+		}
+
+		if a[idx] > b[idx] {
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+
+	return 0
+}
+
+func TestCollation_Compare(t *testing.T) {
+	cases := []struct {
+		collation Collation
+		a, b      string
+		expected  int
+	}{
+		{CodePointCollation, "ґ", "д", 1},
+		{CodePointCollation, "А", "а", -1},
+		{UkrainianCollation, "ґ", "д", -1},
+		{UkrainianCollation, "Ґудзь", "Гончар", 1},
+		{UkrainianCollation, "є", "ж", -1},
+		{UkrainianCollation, "и", "і", -1},
+		{UkrainianCollation, "ї", "й", -1},
+		{UkrainianCollation, "Їжак", "Іваненко", 1},
+		{UkrainianCollation, "альфа", "Бета", -1},
+		{UkrainianCollation, "Альфа", "альфа", -1},
+		{UkrainianCollation, "z", "а", -1},
+		{UkrainianCollation, "я", "ы", -1},
+		{UkrainianCollation, "аб", "абв", -1},
+		{CaseInsensitiveCollation, "Альфа", "альфа", 0},
+		{CaseInsensitiveCollation, "ЄВА", "ґава", 1},
+	}
+
+	for _, c := range cases {
+		name := fmt.Sprintf("%s/%s/%s", c.collation.Name(), c.a, c.b)
+		if actual := c.collation.Compare(c.a, c.b); actual != c.expected {
+			t.Errorf("%s: Compare returned %d, expected %d", name, actual, c.expected)
+		}
+
+		if actual := compareKeys(c.collation.Key(c.a), c.collation.Key(c.b)); actual != c.expected {
+			t.Errorf("%s: keys are compared as %d, expected %d", name, actual, c.expected)
+		}
+	}
+}
+
+func TestCollationByName(t *testing.T) {
+	for _, collation := range []Collation{CodePointCollation, UkrainianCollation, CaseInsensitiveCollation} {
+		actual, err := CollationByName(collation.Name())
+		if err != nil || actual != collation {
+			t.Errorf("CollationByName(%s) returned %d, %v", collation.Name(), actual, err)
+		}
+	}
+
+	if _, err := CollationByName("невідомий"); err == nil {
+		t.Error("CollationByName should fail for an unknown name")
+	}
+}
@@ -1,39 +1,58 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
+// ToInteger converts the value to an integer, strings are parsed in
+// the base passed as the second argument, 10 by default.
 func ToInteger(_ common.State, args ...common.Value) (common.Value, error) {
 	if len(args) == 0 {
 		return NewIntegerInstance(0), nil
 	}
 
-	if len(args) != 1 {
+	if len(args) > 2 {
 		return nil, util.RuntimeError(
 			fmt.Sprintf(
-				"'цілий()' приймає лише один аргумент (отримано %d)", len(args),
+				"'цілий()' приймає один або два аргументи (отримано %d)", len(args),
 			),
 		)
 	}
 
+	if len(args) == 2 {
+		text, ok := args[0].(StringInstance)
+		if !ok {
+			return nil, util.RuntimeError("'цілий()' з основою приймає лише рядок")
+		}
+
+		base, ok := args[1].(IntegerInstance)
+		if !ok || base.Value < 2 || base.Value > 36 {
+			return nil, util.RuntimeError("основа 'цілий()' має бути цілим числом від 2 до 36")
+		}
+
+		value, err := parseInteger(text.Value, int(base.Value))
+		if err != nil {
+			return nil, util.RuntimeError(err.Error())
+		}
+
+		return NewIntegerInstance(value), nil
+	}
+
 	switch vt := args[0].(type) {
 	case RealInstance:
 		return NewIntegerInstance(int64(vt.Value)), nil
 	case IntegerInstance:
 		return vt, nil
 	case StringInstance:
-		intVal, err := strconv.ParseInt(vt.Value, 10, 64)
+		intVal, err := parseInteger(vt.Value, 10)
 		if err != nil {
-			return nil, util.RuntimeError(
-				fmt.Sprintf(
-					"некоректний літерал для функції 'цілий()' з основою 10: '%s'", vt.Value,
-				),
-			)
+			return nil, util.RuntimeError(err.Error())
 		}
 
 		return NewIntegerInstance(intVal), nil
@@ -52,32 +71,80 @@ func ToInteger(_ common.State, args ...common.Value) (common.Value, error) {
 	}
 }
 
+// parseInteger parses the integer in the base, prefixes '0b', '0o' and
+// '0x' are allowed in bases 2, 8 and 16 respectively.
+func parseInteger(text string, base int) (int64, error) {
+	literal := strings.TrimSpace(text)
+	sign := ""
+	if strings.HasPrefix(literal, "-") || strings.HasPrefix(literal, "+") {
+		sign, literal = literal[:1], literal[1:]
+	}
+
+	if len(literal) > 2 && literal[0] == '0' {
+		switch prefix := strings.ToLower(literal[1:2]); {
+		case base == 2 && prefix == "b", base == 8 && prefix == "o", base == 16 && prefix == "x":
+			literal = literal[2:]
+		}
+	}
+
+	value, err := strconv.ParseInt(sign+literal, base, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, errors.New(fmt.Sprintf("число '%s' не вміщується у цілий тип", text))
+	}
+
+	if err != nil {
+		return 0, errors.New(
+			fmt.Sprintf("некоректний літерал для функції 'цілий()' з основою %d: '%s'", base, text),
+		)
+	}
+
+	return value, nil
+}
+
+// ToReal converts the value to a real number, strings are parsed with
+// the decimal comma and thousands separated by spaces if the second
+// argument is true.
 func ToReal(_ common.State, args ...common.Value) (common.Value, error) {
 	if len(args) == 0 {
 		return NewRealInstance(0.0), nil
 	}
 
-	if len(args) != 1 {
+	if len(args) > 2 {
 		return nil, util.RuntimeError(
 			fmt.Sprintf(
-				"функція 'дійсний()' приймає лише один аргумент (отримано %d)", len(args),
+				"функція 'дійсний()' приймає один або два аргументи (отримано %d)", len(args),
 			),
 		)
 	}
 
+	if len(args) == 2 {
+		text, ok := args[0].(StringInstance)
+		if !ok {
+			return nil, util.RuntimeError("'дійсний()' з десятковою комою приймає лише рядок")
+		}
+
+		decimalComma, ok := args[1].(BoolInstance)
+		if !ok {
+			return nil, util.RuntimeError("другий аргумент 'дійсний()' має бути логічного типу")
+		}
+
+		value, err := parseReal(text.Value, decimalComma.Value)
+		if err != nil {
+			return nil, util.RuntimeError(err.Error())
+		}
+
+		return NewRealInstance(value), nil
+	}
+
 	switch vt := args[0].(type) {
 	case RealInstance:
 		return vt, nil
 	case IntegerInstance:
 		return NewRealInstance(float64(vt.Value)), nil
 	case StringInstance:
-		realVal, err := strconv.ParseFloat(vt.Value, 64)
+		realVal, err := parseReal(vt.Value, false)
 		if err != nil {
-			return nil, util.RuntimeError(
-				fmt.Sprintf(
-					"не вдалося перетворити рядок у дійсне число: '%s'", vt.Value,
-				),
-			)
+			return nil, util.RuntimeError(err.Error())
 		}
 
 		return NewRealInstance(realVal), nil
@@ -96,6 +163,45 @@ func ToReal(_ common.State, args ...common.Value) (common.Value, error) {
 	}
 }
 
+// groupSeparators are removed from numbers written with the decimal
+// comma, e.g. '1 234,5'.
+var groupSeparators = strings.NewReplacer(" ", "", "\u00a0", "", "\u202f", "")
+
+func parseReal(text string, decimalComma bool) (float64, error) {
+	literal := strings.TrimSpace(text)
+	if decimalComma {
+		literal = strings.Replace(groupSeparators.Replace(literal), ",", ".", 1)
+	}
+
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil || decimalComma && strings.Contains(text, ".") {
+		return 0, errors.New(fmt.Sprintf("не вдалося перетворити рядок у дійсне число: '%s'", text))
+	}
+
+	return value, nil
+}
+
+// ParseNumber parses an integer, or a real number if the text is not an
+// integer. Unlike conversion functions, the error is not a runtime one,
+// so it may be returned as a value.
+func ParseNumber(text string, decimalComma bool) (common.Value, error) {
+	literal := strings.TrimSpace(text)
+	if decimalComma {
+		literal = groupSeparators.Replace(literal)
+	}
+
+	if value, err := strconv.ParseInt(literal, 10, 64); err == nil {
+		return NewIntegerInstance(value), nil
+	}
+
+	value, err := parseReal(text, decimalComma)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("не вдалося перетворити рядок у число: '%s'", text))
+	}
+
+	return NewRealInstance(value), nil
+}
+
 func ToString(state common.State, args ...common.Value) (common.Value, error) {
 	if len(args) == 0 {
 		return NewStringInstance(""), nil
@@ -138,12 +244,33 @@ func ToBool(state common.State, args ...common.Value) (common.Value, error) {
 	return NewBoolInstance(boolValue), err
 }
 
-func ToList(_ common.State, args ...common.Value) (common.Value, error) {
+func ToList(state common.State, args ...common.Value) (common.Value, error) {
 	list := NewListInstance()
 	if len(args) == 0 {
 		return list, nil
 	}
 
+	if len(args) == 1 {
+		switch iterable := args[0].(type) {
+		case common.IteratorType:
+			values, err := drainIterator(state, iterable)
+			if err != nil {
+				return nil, err
+			}
+
+			list.Values = append(list.Values, values...)
+			return list, nil
+		case RangeInstance:
+			values, err := drainIterator(state, &sequenceIterator{sequence: iterable})
+			if err != nil {
+				return nil, err
+			}
+
+			list.Values = append(list.Values, values...)
+			return list, nil
+		}
+	}
+
 	for _, arg := range args {
 		list.Values = append(list.Values, arg)
 	}
@@ -165,39 +292,35 @@ func ToDictionary(state common.State, args ...common.Value) (common.Value, error
 		)
 	}
 
-	switch keys := args[0].(type) {
-	case ListInstance:
-		switch values := args[1].(type) {
-		case ListInstance:
-			if keys.Length(state) != values.Length(state) {
-				return nil, util.RuntimeError(
-					fmt.Sprintf(
-						"довжина списку ключів має співпадати з довжиною списку значень",
-					),
-				)
-			}
+	keysIterator, err := GetIterator(state, args[0])
+	if err != nil {
+		return nil, util.RuntimeError("функція 'словник()' першим аргументом приймає послідовність ключів")
+	}
 
-			length := keys.Length(state)
-			for i := int64(0); i < length; i++ {
-				err := dict.SetElement(keys.Values[i], values.Values[i])
-				if err != nil {
-					return nil, err
-				}
-			}
+	valuesIterator, err := GetIterator(state, args[1])
+	if err != nil {
+		return nil, util.RuntimeError("функція 'словник()' другим аргументом приймає послідовність значень")
+	}
+
+	keys, err := drainIterator(state, keysIterator)
+	if err != nil {
+		return nil, err
+	}
 
-			return dict, nil
-		default:
-			return nil, util.RuntimeError(
-				fmt.Sprintf(
-					"функція 'словник()' другим аргументом приймає список значень",
-				),
-			)
+	values, err := drainIterator(state, valuesIterator)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) != len(values) {
+		return nil, util.RuntimeError("довжина послідовності ключів має співпадати з довжиною послідовності значень")
+	}
+
+	for i, key := range keys {
+		if err := dict.SetElement(state, key, values[i]); err != nil {
+			return nil, err
 		}
-	default:
-		return nil, util.RuntimeError(
-			fmt.Sprintf(
-				"функція 'словник()' першим аргументом приймає список ключів",
-			),
-		)
 	}
+
+	return dict, nil
 }
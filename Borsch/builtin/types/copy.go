@@ -0,0 +1,126 @@
+package types
+
+import (
+	"reflect"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+// copier makes deep copies of values. It remembers already copied
+// objects, so references shared between parts of the value and cycles
+// are kept in the copy.
+type copier struct {
+	state  common.State
+	copied map[uintptr]common.Value
+}
+
+// DeepCopy recursively copies lists, dictionaries and objects of user
+// classes, other values are immutable and returned as is. Objects which
+// have common.CopyOperatorName are copied by it.
+func DeepCopy(state common.State, value common.Value) (common.Value, error) {
+	c := &copier{state: state, copied: map[uintptr]common.Value{}}
+	return c.copy(value)
+}
+
+func (c *copier) copy(value common.Value) (common.Value, error) {
+	id, hasId := identityOf(value)
+	if hasId {
+		if copied, ok := c.copied[id]; ok {
+			return copied, nil
+		}
+	}
+
+	switch original := value.(type) {
+	case *ListInstance:
+		copied := NewListInstance()
+		copied.Values = make([]common.Value, len(original.Values))
+		c.copied[id] = copied
+
+		for idx, element := range original.Values {
+			element, err := c.copy(element)
+			if err != nil {
+				return nil, err
+			}
+
+			copied.Values[idx] = element
+		}
+
+		return copied, nil
+	case *DictionaryInstance:
+		copied := NewDictionaryInstance()
+		c.copied[id] = copied
+		for _, entry := range original.entries {
+			key, err := c.copy(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := c.copy(entry.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			copied.addEntry(DictionaryEntry{Key: key, Value: value, hash: entry.hash})
+		}
+
+		return copied, nil
+	case *ClassInstance:
+		if operator, err := original.GetOperator(common.CopyOperatorName); err == nil {
+			copied, err := CallAttribute(c.state, original, operator, common.CopyOperatorName, nil, nil, true)
+			if err != nil {
+				return nil, err
+			}
+
+			c.copied[id] = copied
+			return copied, nil
+		}
+
+		copied := original.Copy()
+		c.copied[id] = copied
+		for name, attribute := range original.attributes {
+			attribute, err := c.copy(attribute)
+			if err != nil {
+				return nil, err
+			}
+
+			copied.attributes[name] = attribute
+		}
+
+		return copied, nil
+	}
+
+	return value, nil
+}
+
+// ShallowCopy creates a new list, dictionary or object of a user class
+// with the same elements or attributes, other values are immutable and
+// returned as is.
+func ShallowCopy(value common.Value) common.Value {
+	switch original := value.(type) {
+	case *ListInstance:
+		copied := NewListInstance()
+		copied.Values = append(copied.Values, original.Values...)
+		return copied
+	case *DictionaryInstance:
+		copied := NewDictionaryInstance()
+		for _, entry := range original.entries {
+			copied.addEntry(*entry)
+		}
+
+		return copied
+	case *ClassInstance:
+		return original.Copy()
+	}
+
+	return value
+}
+
+// identityOf returns an address which identifies the mutable value.
+func identityOf(value common.Value) (uintptr, bool) {
+	switch value.(type) {
+	case *ListInstance, *DictionaryInstance, *ClassInstance:
+		return reflect.ValueOf(value).Pointer(), true
+	}
+
+	return 0, false
+}
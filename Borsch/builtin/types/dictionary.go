@@ -1,11 +1,8 @@
 package types
 
 import (
-	"crypto/sha256"
-	"encoding/binary"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
@@ -14,15 +11,25 @@ import (
 type DictionaryEntry struct {
 	Key   common.Value
 	Value common.Value
+
+	hash uint64
 }
 
+// DictionaryInstance is a reference object like ListInstance. Entries
+// are iterated in order of insertion of their keys.
 type DictionaryInstance struct {
 	BuiltinInstance
-	Map map[uint64]DictionaryEntry
+
+	// buckets holds entries by hashes of their keys, keys with the same
+	// hash are distinguished by common.EqualsOp.
+	buckets map[uint64][]*DictionaryEntry
+
+	// entries holds entries in order of insertion of their keys.
+	entries []*DictionaryEntry
 }
 
-func NewDictionaryInstance() DictionaryInstance {
-	return DictionaryInstance{
+func NewDictionaryInstance() *DictionaryInstance {
+	dict := &DictionaryInstance{
 		BuiltinInstance: BuiltinInstance{
 			ClassInstance{
 				class:      Dictionary,
@@ -30,59 +37,109 @@ func NewDictionaryInstance() DictionaryInstance {
 				address:    "",
 			},
 		},
-		Map: map[uint64]DictionaryEntry{},
+		buckets: map[uint64][]*DictionaryEntry{},
 	}
+
+	dict.address = fmt.Sprintf("%p", dict)
+	return dict
 }
 
-func (t DictionaryInstance) calcHash(obj interface{}) (uint64, error) {
-	h := sha256.New()
-	_, err := h.Write([]byte(fmt.Sprintf("%v", obj)))
-	if err != nil {
-		return 0, err
+// Entries returns entries of the dictionary in order of insertion.
+func (t *DictionaryInstance) Entries() []DictionaryEntry {
+	entries := make([]DictionaryEntry, 0, len(t.entries))
+	for _, entry := range t.entries {
+		entries = append(entries, *entry)
 	}
 
-	return binary.BigEndian.Uint64(h.Sum(nil)), nil
+	return entries
 }
 
-func (t DictionaryInstance) String(state common.State) (string, error) {
-	return t.Representation(state)
-}
+// find returns the entry with the key, or nil if the dictionary does
+// not contain it, and hash of the key.
+func (t *DictionaryInstance) find(state common.State, key common.Value) (*DictionaryEntry, uint64, error) {
+	hash, err := Hash(state, key)
+	if err != nil {
+		return nil, 0, err
+	}
 
-func (t DictionaryInstance) Representation(state common.State) (string, error) {
-	var strValues []string
-	for _, value := range t.Map {
-		keyRepresentation, err := value.Key.Representation(state)
+	for _, entry := range t.buckets[hash] {
+		equals, err := keysEqual(state, entry.Key, key)
 		if err != nil {
-			return "", err
+			return nil, 0, err
 		}
 
-		valueRepresentation, err := value.Value.Representation(state)
-		if err != nil {
-			return "", err
+		if equals {
+			return entry, hash, nil
+		}
+	}
+
+	return nil, hash, nil
+}
+
+// addEntry adds the entry without checking if the dictionary already
+// contains its key.
+func (t *DictionaryInstance) addEntry(entry DictionaryEntry) {
+	t.buckets[entry.hash] = append(t.buckets[entry.hash], &entry)
+	t.entries = append(t.entries, &entry)
+}
+
+func (t *DictionaryInstance) removeEntry(entry *DictionaryEntry) {
+	t.buckets[entry.hash] = removeEntryPointer(t.buckets[entry.hash], entry)
+	if len(t.buckets[entry.hash]) == 0 {
+		delete(t.buckets, entry.hash)
+	}
+
+	t.entries = removeEntryPointer(t.entries, entry)
+}
+
+func (t *DictionaryInstance) clear() {
+	t.buckets = map[uint64][]*DictionaryEntry{}
+	t.entries = nil
+}
+
+func removeEntryPointer(entries []*DictionaryEntry, entry *DictionaryEntry) []*DictionaryEntry {
+	for idx, current := range entries {
+		if current == entry {
+			return append(entries[:idx], entries[idx+1:]...)
 		}
+	}
+
+	return entries
+}
 
-		strValues = append(strValues, fmt.Sprintf("%s: %s", keyRepresentation, valueRepresentation))
+// keysEqual compares keys which have the same hash.
+func keysEqual(state common.State, a, b common.Value) (bool, error) {
+	if sameObject(a, b) {
+		return true, nil
 	}
 
-	return "{" + strings.Join(strValues, ", ") + "}", nil
+	return Equals(state, a, b)
 }
 
-func (t DictionaryInstance) AsBool(state common.State) (bool, error) {
+func (t *DictionaryInstance) String(state common.State) (string, error) {
+	return t.Representation(state)
+}
+
+func (t *DictionaryInstance) Representation(state common.State) (string, error) {
+	return newContainerRepresentation(state).represent(t)
+}
+
+func (t *DictionaryInstance) AsBool(state common.State) (bool, error) {
 	return t.Length(state) != 0, nil
 }
 
-func (t DictionaryInstance) Length(common.State) int64 {
-	return int64(len(t.Map))
+func (t *DictionaryInstance) Length(common.State) int64 {
+	return int64(len(t.entries))
 }
 
-func (t DictionaryInstance) GetElement(state common.State, key common.Value) (common.Value, error) {
-	keyHash, err := t.calcHash(key)
+func (t *DictionaryInstance) GetElement(state common.State, key common.Value) (common.Value, error) {
+	entry, _, err := t.find(state, key)
 	if err != nil {
 		return nil, err
 	}
 
-	if value, ok := t.Map[keyHash]; ok {
-		return value.Value, nil
+	if entry != nil {
+		return entry.Value, nil
 	}
 
 	keyStr, err := key.String(state)
@@ -93,24 +150,28 @@ func (t DictionaryInstance) GetElement(state common.State, key common.Value) (co
 	return nil, errors.New(fmt.Sprintf("значення за ключем '%s' не існує", keyStr))
 }
 
-func (t *DictionaryInstance) SetElement(key common.Value, value common.Value) error {
-	keyHash, err := t.calcHash(key)
+func (t *DictionaryInstance) SetElement(state common.State, key common.Value, value common.Value) error {
+	entry, hash, err := t.find(state, key)
 	if err != nil {
 		return err
 	}
 
-	t.Map[keyHash] = DictionaryEntry{Key: key, Value: value}
+	if entry != nil {
+		entry.Value = value
+		return nil
+	}
+
+	t.addEntry(DictionaryEntry{Key: key, Value: value, hash: hash})
 	return nil
 }
 
 func (t *DictionaryInstance) RemoveElement(state common.State, key common.Value) (common.Value, error) {
-	keyHash, err := t.calcHash(key)
+	entry, _, err := t.find(state, key)
 	if err != nil {
 		return nil, err
 	}
 
-	value, ok := t.Map[keyHash]
-	if !ok {
+	if entry == nil {
 		keyStr, err := key.String(state)
 		if err != nil {
 			return nil, err
@@ -119,23 +180,57 @@ func (t *DictionaryInstance) RemoveElement(state common.State, key common.Value)
 		return nil, errors.New(fmt.Sprintf("значення за ключем '%s' не існує", keyStr))
 	}
 
-	delete(t.Map, keyHash)
-	return value.Value, nil
+	t.removeEntry(entry)
+	return entry.Value, nil
 }
 
-func compareDictionaries(_ common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
+func compareDictionaries(state common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
 	switch right := other.(type) {
 	case NilInstance:
-	case *DictionaryInstance, DictionaryInstance:
-		return -2, util.OperandsNotSupportedError(op, self.GetTypeName(), right.GetTypeName())
+	case *DictionaryInstance:
+		if op != common.EqualsOp && op != common.NotEqualsOp {
+			return notComparable, nil
+		}
+
+		return newContainerComparison(state).compare(op, self, right)
 	default:
-		return -2, util.OperatorNotSupportedError(op, self.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
 	return -2, nil
 }
 
+// compareEntries returns 0 if dictionaries have equal values by equal
+// keys regardless of the order of insertion.
+func (c *containerComparison) compareEntries(left, right *DictionaryInstance) (int, error) {
+	if len(left.entries) != len(right.entries) {
+		return -2, nil
+	}
+
+	for _, entry := range left.entries {
+		value, ok, err := right.lookup(c.state, entry.Key)
+		if err != nil {
+			return 0, err
+		}
+
+		if !ok {
+			return -2, nil
+		}
+
+		equals, err := c.call(common.EqualsOp, entry.Value, value)
+		if err != nil {
+			return 0, err
+		}
+
+		if !equals {
+			return -2, nil
+		}
+	}
+
+	return 0, nil
+}
+
 func newDictionaryClass() *Class {
 	initAttributes := func(attrs *map[string]common.Value) {
 		*attrs = MergeAttributes(
@@ -144,7 +239,7 @@ func newDictionaryClass() *Class {
 				common.ConstructorName: newBuiltinConstructor(Dictionary, ToDictionary, ""),
 
 				// TODO: add doc
-				common.LengthOperatorName: newLengthOperator(List, getLength, ""),
+				common.LengthOperatorName: newLengthOperator(Dictionary, getLength, ""),
 				"вилучити": NewFunctionInstance(
 					"вилучити",
 					[]FunctionParameter{
@@ -155,14 +250,14 @@ func newDictionaryClass() *Class {
 							IsNullable: false,
 						},
 						{
-							Type:       nil,
+							Type:       Any,
 							Name:       "ключ",
 							IsVariadic: false,
 							IsNullable: true,
 						},
 					},
 					func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
-						dict := (*args)[0].(DictionaryInstance)
+						dict := (*args)[0].(*DictionaryInstance)
 						value, err := dict.RemoveElement(state, (*args)[1])
 						if err != nil {
 							return nil, util.RuntimeError(err.Error())
@@ -181,6 +276,7 @@ func newDictionaryClass() *Class {
 					"", // TODO: add doc
 				),
 			},
+			makeDictionaryMethods(),
 			MakeLogicalOperators(Dictionary),
 			MakeComparisonOperators(Dictionary, compareDictionaries),
 			MakeCommonOperators(Dictionary),
@@ -193,6 +289,10 @@ func newDictionaryClass() *Class {
 		Bases:           []*Class{},
 		Parent:          BuiltinPackage,
 		AttrInitializer: initAttributes,
+		TypeParameters: []*Class{
+			NewTypeParameter("К", BuiltinPackage),
+			NewTypeParameter("З", BuiltinPackage),
+		},
 		GetEmptyInstance: func() (common.Value, error) {
 			return NewDictionaryInstance(), nil
 		},
@@ -0,0 +1,226 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+func newDictionaryMethod(
+	name string,
+	parameters []FunctionParameter,
+	handler func(common.State, *DictionaryInstance, []common.Value) (common.Value, error),
+	returnType *Class,
+	doc string,
+) *FunctionInstance {
+	return NewFunctionInstance(
+		name,
+		append(
+			[]FunctionParameter{
+				{
+					Type:       Dictionary,
+					Name:       "я",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			parameters...,
+		),
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return handler(state, (*args)[0].(*DictionaryInstance), (*args)[1:])
+		},
+		[]FunctionReturnType{
+			{
+				Type:       returnType,
+				IsNullable: returnType == Any,
+			},
+		},
+		true,
+		nil,
+		doc,
+	)
+}
+
+// lookup returns the value by the key and true, or nil and false if
+// the dictionary does not contain the key.
+func (t *DictionaryInstance) lookup(state common.State, key common.Value) (common.Value, bool, error) {
+	entry, _, err := t.find(state, key)
+	if err != nil || entry == nil {
+		return nil, false, err
+	}
+
+	return entry.Value, true, nil
+}
+
+func (t *DictionaryInstance) update(state common.State, other common.Value) error {
+	switch o := other.(type) {
+	case *DictionaryInstance:
+		for _, entry := range o.Entries() {
+			if err := t.SetElement(state, entry.Key, entry.Value); err != nil {
+				return err
+			}
+		}
+	default:
+		return util.RuntimeError(
+			fmt.Sprintf(
+				"неможливо оновити словник значенням типу '%s'", other.GetTypeName(),
+			),
+		)
+	}
+
+	return nil
+}
+
+func newDictionaryGetMethod(parameters []FunctionParameter, doc string) *FunctionInstance {
+	return newDictionaryMethod(
+		"отримати",
+		parameters,
+		func(state common.State, self *DictionaryInstance, args []common.Value) (common.Value, error) {
+			value, ok, err := self.lookup(state, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				return value, nil
+			}
+
+			if len(args) > 1 {
+				return args[1], nil
+			}
+
+			return NewNilInstance(), nil
+		},
+		Any,
+		doc,
+	)
+}
+
+func makeDictionaryMethods() map[string]common.Value {
+	return map[string]common.Value{
+		"ключі": newDictionaryMethod(
+			"ключі",
+			[]FunctionParameter{},
+			func(_ common.State, self *DictionaryInstance, _ []common.Value) (common.Value, error) {
+				list := NewListInstance()
+				for _, entry := range self.Entries() {
+					list.Values = append(list.Values, entry.Key)
+				}
+
+				return list, nil
+			},
+			List,
+			"Повертає список ключів словника в порядку їх додавання.",
+		),
+		"значення": newDictionaryMethod(
+			"значення",
+			[]FunctionParameter{},
+			func(_ common.State, self *DictionaryInstance, _ []common.Value) (common.Value, error) {
+				list := NewListInstance()
+				for _, entry := range self.Entries() {
+					list.Values = append(list.Values, entry.Value)
+				}
+
+				return list, nil
+			},
+			List,
+			"Повертає список значень словника в порядку додавання їх ключів.",
+		),
+		"пари": newDictionaryMethod(
+			"пари",
+			[]FunctionParameter{},
+			func(_ common.State, self *DictionaryInstance, _ []common.Value) (common.Value, error) {
+				list := NewListInstance()
+				for _, entry := range self.Entries() {
+					pair := NewListInstance()
+					pair.Values = append(pair.Values, entry.Key, entry.Value)
+					list.Values = append(list.Values, pair)
+				}
+
+				return list, nil
+			},
+			List,
+			"Повертає список пар [ключ, значення] в порядку додавання ключів.",
+		),
+		"отримати": MustOverload(
+			newDictionaryGetMethod(
+				[]FunctionParameter{newElementParameter("ключ")},
+				"Повертає значення за ключем або нуль, якщо ключа немає.",
+			),
+			newDictionaryGetMethod(
+				[]FunctionParameter{
+					newElementParameter("ключ"),
+					newElementParameter("за_замовчуванням"),
+				},
+				"Повертає значення за ключем або значення за замовчуванням, якщо ключа немає.",
+			),
+		),
+		"містить": newDictionaryMethod(
+			"містить",
+			[]FunctionParameter{newElementParameter("ключ")},
+			func(state common.State, self *DictionaryInstance, args []common.Value) (common.Value, error) {
+				_, ok, err := self.lookup(state, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				return NewBoolInstance(ok), nil
+			},
+			Bool,
+			"Перевіряє, чи словник містить ключ.",
+		),
+		"оновити": newDictionaryMethod(
+			"оновити",
+			[]FunctionParameter{newElementParameter("інший")},
+			func(state common.State, self *DictionaryInstance, args []common.Value) (common.Value, error) {
+				return NewNilInstance(), self.update(state, args[0])
+			},
+			Nil,
+			"Додає до словника пари іншого словника, замінюючи значення наявних ключів.",
+		),
+		"злити": newDictionaryMethod(
+			"злити",
+			[]FunctionParameter{newElementParameter("інший")},
+			func(state common.State, self *DictionaryInstance, args []common.Value) (common.Value, error) {
+				merged := ShallowCopy(self).(*DictionaryInstance)
+				if err := merged.update(state, args[0]); err != nil {
+					return nil, err
+				}
+
+				return merged, nil
+			},
+			Dictionary,
+			"Повертає новий словник з парами цього й іншого словника, значення з іншого мають перевагу.",
+		),
+		"очистити": newDictionaryMethod(
+			"очистити",
+			[]FunctionParameter{},
+			func(_ common.State, self *DictionaryInstance, _ []common.Value) (common.Value, error) {
+				self.clear()
+				return NewNilInstance(), nil
+			},
+			Nil,
+			"Вилучає всі пари словника.",
+		),
+		"встановити_за_замовчуванням": newDictionaryMethod(
+			"встановити_за_замовчуванням",
+			[]FunctionParameter{newElementParameter("ключ"), newElementParameter("значення")},
+			func(state common.State, self *DictionaryInstance, args []common.Value) (common.Value, error) {
+				value, ok, err := self.lookup(state, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if ok {
+					return value, nil
+				}
+
+				return args[1], self.SetElement(state, args[0], args[1])
+			},
+			Any,
+			"Повертає значення за ключем, а якщо ключа немає, додає його із заданим значенням "+
+				"і повертає це значення.",
+		),
+	}
+}
@@ -0,0 +1,247 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// EnumMemberInstance is a named constant of an enumeration.
+type EnumMemberInstance struct {
+	BuiltinInstance
+	Name    string
+	Ordinal int64
+	Value   common.Value
+}
+
+func newEnumMemberInstance(enum *Class, name string, ordinal int64, value common.Value) *EnumMemberInstance {
+	member := &EnumMemberInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance{
+				class: enum,
+				attributes: map[string]common.Value{
+					"назва":    NewStringInstance(name),
+					"порядок":  NewIntegerInstance(ordinal),
+					"значення": value,
+				},
+				address: "",
+			},
+		},
+		Name:    name,
+		Ordinal: ordinal,
+		Value:   value,
+	}
+
+	member.address = fmt.Sprintf("%p", member)
+	return member
+}
+
+func (m *EnumMemberInstance) String(common.State) (string, error) {
+	return m.GetTypeName() + "." + m.Name, nil
+}
+
+func (m *EnumMemberInstance) Representation(state common.State) (string, error) {
+	valueRepresentation, err := m.Value.Representation(state)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("<%s.%s: %s>", m.GetTypeName(), m.Name, valueRepresentation), nil
+}
+
+func (m *EnumMemberInstance) AsBool(common.State) (bool, error) {
+	return true, nil
+}
+
+func compareEnumMembers(_ common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
+	left, ok := self.(*EnumMemberInstance)
+	if !ok {
+		return 0, util.IncorrectUseOfFunctionError("compareEnumMembers")
+	}
+
+	if right, ok := other.(*EnumMemberInstance); ok && right.GetClass() == left.GetClass() {
+		if left.Ordinal == right.Ordinal {
+			return 0, nil
+		}
+
+		if left.Ordinal < right.Ordinal {
+			return -1, nil
+		}
+
+		return 1, nil
+	}
+
+	return notComparable, nil
+}
+
+// enumMethodNames are names of methods generated for each enumeration,
+// members can not be named the same.
+var enumMethodNames = []string{"елементи", "за_назвою", "за_значенням"}
+
+// NewEnumClass creates a final class with members of an enumeration,
+// which have given names and values. Ordinals of members start from 0.
+// Members and generated methods can not be reassigned or removed.
+func NewEnumClass(name string, parent common.Value, names []string, values []common.Value) (*Class, error) {
+	enum := &Class{
+		Name:    name,
+		IsFinal: true,
+		Bases:   []*Class{},
+		Parent:  parent,
+	}
+
+	enum.GetEmptyInstance = func() (common.Value, error) {
+		return nil, util.RuntimeError(fmt.Sprintf("неможливо створити новий елемент переліку '%s'", name))
+	}
+
+	attributes := map[string]common.Value{}
+	for i, memberName := range names {
+		if _, ok := attributes[memberName]; ok {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("елемент '%s' вже визначений у переліку '%s'", memberName, name),
+			)
+		}
+
+		for _, methodName := range enumMethodNames {
+			if memberName == methodName {
+				return nil, util.RuntimeError(
+					fmt.Sprintf("назва '%s' зарезервована для методу переліку '%s'", memberName, name),
+				)
+			}
+		}
+
+		member := newEnumMemberInstance(enum, memberName, int64(i), values[i])
+		enum.enumMembers = append(enum.enumMembers, member)
+		attributes[memberName] = member
+	}
+
+	enum.AttrInitializer = func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			attributes,
+			map[string]common.Value{
+				"елементи": newEnumFunction(
+					"елементи",
+					[]FunctionParameter{},
+					func(_ common.State, _ []common.Value) (common.Value, error) {
+						list := NewListInstance()
+						for _, member := range enum.enumMembers {
+							list.Values = append(list.Values, member)
+						}
+
+						return list, nil
+					},
+					List,
+					"Повертає список елементів переліку в порядку їх оголошення.",
+				),
+				"за_назвою": newEnumFunction(
+					"за_назвою",
+					[]FunctionParameter{
+						{
+							Type:       String,
+							Name:       "назва",
+							IsVariadic: false,
+							IsNullable: false,
+						},
+					},
+					func(_ common.State, args []common.Value) (common.Value, error) {
+						memberName := args[0].(StringInstance).Value
+						for _, member := range enum.enumMembers {
+							if member.Name == memberName {
+								return member, nil
+							}
+						}
+
+						return nil, util.RuntimeError(
+							fmt.Sprintf("перелік '%s' не містить елемента з назвою '%s'", name, memberName),
+						)
+					},
+					enum,
+					"Повертає елемент переліку з назвою.",
+				),
+				"за_значенням": newEnumFunction(
+					"за_значенням",
+					[]FunctionParameter{
+						{
+							Type:       Any,
+							Name:       "значення",
+							IsVariadic: false,
+							IsNullable: true,
+						},
+					},
+					func(state common.State, args []common.Value) (common.Value, error) {
+						for _, member := range enum.enumMembers {
+							if member.Value.(ObjectInstance).GetClass() != args[0].(ObjectInstance).GetClass() {
+								continue
+							}
+
+							equals, err := Equals(state, member.Value, args[0])
+							if err != nil {
+								return nil, err
+							}
+
+							if equals {
+								return member, nil
+							}
+						}
+
+						valueRepresentation, err := args[0].Representation(state)
+						if err != nil {
+							return nil, err
+						}
+
+						return nil, util.RuntimeError(
+							fmt.Sprintf("перелік '%s' не містить елемента зі значенням %s", name, valueRepresentation),
+						)
+					},
+					enum,
+					"Повертає елемент переліку зі значенням того самого типу, що дорівнює заданому.",
+				),
+			},
+			MakeComparisonOperators(enum, compareEnumMembers),
+			MakeLogicalOperators(enum),
+			MakeCommonOperators(enum),
+		)
+	}
+
+	enum.FreezeAttributes(names...)
+	enum.FreezeAttributes(enumMethodNames...)
+	enum.Setup()
+	if !enum.IsValid() {
+		panic("enum is invalid")
+	}
+
+	return enum, nil
+}
+
+// IsEnum checks if the class is created by NewEnumClass.
+func (c *Class) IsEnum() bool {
+	return c.enumMembers != nil
+}
+
+func newEnumFunction(
+	name string,
+	parameters []FunctionParameter,
+	handler func(common.State, []common.Value) (common.Value, error),
+	returnType *Class,
+	doc string,
+) *FunctionInstance {
+	function := NewFunctionInstance(
+		name,
+		parameters,
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return handler(state, *args)
+		},
+		[]FunctionReturnType{
+			{
+				Type:       returnType,
+				IsNullable: false,
+			},
+		},
+		true,
+		nil,
+		doc,
+	)
+
+	function.IsStatic = true
+	return function
+}
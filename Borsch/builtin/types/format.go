@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// formatSpec is a parsed specification of the number format which is
+// written as '[роздільник][.точність][вид]', where the thousands separator
+// is one of ',', '_' or ' ', and the kind is 'f' for fixed precision,
+// 'e' for scientific notation or 'g' for the shortest of them.
+type formatSpec struct {
+	separator string
+	precision int
+	kind      byte
+}
+
+func parseFormatSpec(spec string) (formatSpec, error) {
+	result := formatSpec{precision: -1, kind: 'f'}
+	rest := spec
+	if len(rest) > 0 && strings.ContainsRune(",_ ", rune(rest[0])) {
+		result.separator, rest = rest[:1], rest[1:]
+	}
+
+	if strings.HasPrefix(rest, ".") {
+		end := 1
+		for end < len(rest) && rest[end] >= '0' && rest[end] <= '9' {
+			end++
+		}
+
+		precision, err := strconv.Atoi(rest[1:end])
+		if err != nil {
+			return result, invalidFormatSpecError(spec)
+		}
+
+		result.precision, rest = precision, rest[end:]
+	}
+
+	if len(rest) == 1 && strings.Contains("feg", rest) {
+		result.kind, rest = rest[0], ""
+	}
+
+	if len(rest) != 0 {
+		return result, invalidFormatSpecError(spec)
+	}
+
+	return result, nil
+}
+
+func invalidFormatSpecError(spec string) error {
+	return util.RuntimeError(fmt.Sprintf("некоректна специфікація формату: '%s'", spec))
+}
+
+// Format writes the number as the specification describes, the decimal
+// point is replaced by the comma if 'decimalComma' is true.
+func Format(number common.Value, spec string, decimalComma bool) (string, error) {
+	format, err := parseFormatSpec(spec)
+	if err != nil {
+		return "", err
+	}
+
+	if decimalComma && format.separator == "," {
+		return "", util.RuntimeError("роздільник тисяч не може збігатися з десятковою комою")
+	}
+
+	var text string
+	switch v := number.(type) {
+	case IntegerInstance:
+		if format.kind == 'f' {
+			// integers are not converted to reals to keep all digits
+			text = strconv.FormatInt(v.Value, 10)
+			if format.precision > 0 {
+				text += "." + strings.Repeat("0", format.precision)
+			}
+		} else {
+			text = strconv.FormatFloat(float64(v.Value), format.kind, format.precision, 64)
+		}
+	case RealInstance:
+		text = strconv.FormatFloat(v.Value, format.kind, format.precision, 64)
+	default:
+		return "", util.RuntimeError(
+			fmt.Sprintf("'формат' приймає лише числа, отримано '%s'", number.GetTypeName()),
+		)
+	}
+
+	return localizeNumber(text, format.separator, decimalComma), nil
+}
+
+// localizeNumber separates thousands of the integer part of the number
+// and replaces the decimal point.
+func localizeNumber(text string, separator string, decimalComma bool) string {
+	sign := ""
+	if strings.HasPrefix(text, "-") {
+		sign, text = "-", text[1:]
+	}
+
+	integer, fraction := text, ""
+	if idx := strings.IndexAny(text, ".e"); idx >= 0 {
+		integer, fraction = text[:idx], text[idx:]
+	}
+
+	if decimalComma && strings.HasPrefix(fraction, ".") {
+		fraction = "," + fraction[1:]
+	}
+
+	if len(separator) != 0 && strings.Trim(integer, "0123456789") == "" {
+		var groups []string
+		for len(integer) > 3 {
+			groups = append([]string{integer[len(integer)-3:]}, groups...)
+			integer = integer[:len(integer)-3]
+		}
+
+		integer = strings.Join(append([]string{integer}, groups...), separator)
+	}
+
+	return sign + integer + fraction
+}
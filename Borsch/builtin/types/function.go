@@ -13,6 +13,10 @@ type FunctionParameter struct {
 	Name       string
 	IsVariadic bool
 	IsNullable bool
+
+	// TypeArguments parametrize generic types, e.g. types
+	// of elements of a list
+	TypeArguments []TypeArgument
 }
 
 func (fa *FunctionParameter) String() string {
@@ -25,13 +29,7 @@ func (fa *FunctionParameter) String() string {
 }
 
 func (fa FunctionParameter) GetTypeName() string {
-	res := ""
-	if fa.Type != Any {
-		res = fa.Type.GetTypeName()
-	} else {
-		res = common.AnyTypeName
-	}
-
+	res := typeName(fa.Type, fa.TypeArguments)
 	if fa.IsNullable {
 		res += "?"
 	}
@@ -39,9 +37,25 @@ func (fa FunctionParameter) GetTypeName() string {
 	return res
 }
 
+// equals checks if the parameters accept values of the same types in
+// the same way, names of the parameters are not compared.
+func (fa FunctionParameter) equals(other FunctionParameter) bool {
+	return fa.Type == other.Type &&
+		fa.IsVariadic == other.IsVariadic &&
+		fa.IsNullable == other.IsNullable &&
+		equalTypeArguments(fa.TypeArguments, other.TypeArguments)
+}
+
+// Accepts checks if the value can be bound to the parameter.
+func (fa FunctionParameter) Accepts(value common.Value) bool {
+	var bindings *TypeBindings
+	return bindings.Matches(value, fa.Type, fa.IsNullable, fa.TypeArguments)
+}
+
 type FunctionReturnType struct {
-	Type       *Class
-	IsNullable bool
+	Type          *Class
+	IsNullable    bool
+	TypeArguments []TypeArgument
 }
 
 func (r *FunctionReturnType) String() string {
@@ -54,22 +68,40 @@ func (r *FunctionReturnType) String() string {
 }
 
 func (r *FunctionReturnType) GetTypeName() string {
-	if r.Type != Any {
-		return r.Type.GetTypeName()
-	}
-
-	return common.AnyTypeName
+	return typeName(r.Type, r.TypeArguments)
 }
 
 type FunctionInstance struct {
 	ClassInstance
 	package_    *PackageInstance
+	context     common.Context
 	address     string
 	Name        string
 	Parameters  []FunctionParameter
 	ReturnTypes []FunctionReturnType
 	IsMethod    bool
-	callFunc    func(common.State, *[]common.Value, *map[string]common.Value) (common.Value, error)
+
+	// TypeParameters of a generic function are bound to types
+	// of arguments on each call.
+	TypeParameters []*Class
+
+	// IsStatic functions are not bound to an instance when called
+	// as methods, IsClassMethod ones are bound to the class instead.
+	IsStatic      bool
+	IsClassMethod bool
+
+	// IsAbstract methods have no body and should be implemented
+	// in derived classes.
+	IsAbstract bool
+
+	// DefiningClass is the class the method is defined in, the method
+	// accesses its bases via 'батько()'.
+	DefiningClass *Class
+
+	// overloads are implementations of the function with different
+	// parameter types, the call is dispatched to one of them.
+	overloads []*FunctionInstance
+	callFunc  func(common.State, *[]common.Value, *map[string]common.Value) (common.Value, error)
 }
 
 func NewFunctionInstance(
@@ -146,6 +178,10 @@ func (i FunctionInstance) Call(state common.State, args *[]common.Value, kwargs
 }
 
 func (i *FunctionInstance) GetContext() common.Context {
+	if i.context != nil {
+		return i.context
+	}
+
 	if i.package_ != nil {
 		return i.package_.GetContext()
 	}
@@ -153,6 +189,58 @@ func (i *FunctionInstance) GetContext() common.Context {
 	return nil
 }
 
+// SetContext binds the function to the context it was defined in,
+// so the body is able to access variables of enclosing functions.
+func (i *FunctionInstance) SetContext(ctx common.Context) {
+	i.context = ctx
+}
+
+// HasSignatureOf checks if the method accepts and returns values of the
+// same types as the other one does. The first parameters are not
+// compared, since they are instances of different classes. An
+// overloaded method has the signature if each overload of the other
+// one has a matching overload.
+func (i *FunctionInstance) HasSignatureOf(other *FunctionInstance) bool {
+	for _, expected := range other.variants() {
+		isFound := false
+		for _, overload := range i.variants() {
+			if overload.hasSignatureOf(expected) {
+				isFound = true
+				break
+			}
+		}
+
+		if !isFound {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (i *FunctionInstance) hasSignatureOf(other *FunctionInstance) bool {
+	if len(i.Parameters) != len(other.Parameters) || len(i.ReturnTypes) != len(other.ReturnTypes) {
+		return false
+	}
+
+	for idx := 1; idx < len(i.Parameters); idx++ {
+		if !i.Parameters[idx].equals(other.Parameters[idx]) {
+			return false
+		}
+	}
+
+	for idx, returnType := range i.ReturnTypes {
+		otherType := other.ReturnTypes[idx]
+		if returnType.Type != otherType.Type ||
+			returnType.IsNullable != otherType.IsNullable ||
+			!equalTypeArguments(returnType.TypeArguments, otherType.TypeArguments) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (i *FunctionInstance) IsLambda() bool {
 	return i.Name == common.LambdaSignature
 }
@@ -171,7 +259,7 @@ func newFunctionClass() *Class {
 							IsNullable: false,
 						},
 						{
-							Type:       nil,
+							Type:       Any,
 							Name:       "значення",
 							IsVariadic: true,
 							IsNullable: true,
@@ -181,11 +269,15 @@ func newFunctionClass() *Class {
 						common.Value,
 						error,
 					) {
-						function := (*args)[0].(*FunctionInstance)
+						function, err := (*args)[0].(*FunctionInstance).resolveOverload((*args)[1:])
+						if err != nil {
+							return nil, err
+						}
+
 						slicedArgs := (*args)[1:]
 						slicedKwargs := *kwargs
 						delete(slicedKwargs, "я")
-						if err := CheckFunctionArguments(function, &slicedArgs, &slicedKwargs); err != nil {
+						if _, err := CheckFunctionArguments(function, &slicedArgs, &slicedKwargs); err != nil {
 							return nil, err
 						}
 
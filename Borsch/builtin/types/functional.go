@@ -0,0 +1,299 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+func getIterators(state common.State, iterables []common.Value) ([]common.IteratorType, error) {
+	iterators := make([]common.IteratorType, len(iterables))
+	for idx, iterable := range iterables {
+		iterator, err := GetIterator(state, iterable)
+		if err != nil {
+			return nil, err
+		}
+
+		iterators[idx] = iterator
+	}
+
+	return iterators, nil
+}
+
+// nextOfAll returns next values of all iterators, false if any of them
+// is exhausted.
+func nextOfAll(state common.State, iterators []common.IteratorType) ([]common.Value, bool, error) {
+	values := make([]common.Value, len(iterators))
+	for idx, iterator := range iterators {
+		value, ok, err := iterator.Next(state)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+
+		values[idx] = value
+	}
+
+	return values, true, nil
+}
+
+// Map lazily calls the function with elements of the iterables at the
+// same positions until the shortest of them is exhausted.
+func Map(state common.State, function common.Value, iterables []common.Value) (*IteratorInstance, error) {
+	iterators, err := getIterators(state, iterables)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIteratorInstance(
+		"відобразити", func(state common.State) (common.Value, bool, error) {
+			values, ok, err := nextOfAll(state, iterators)
+			if err != nil || !ok {
+				return nil, false, err
+			}
+
+			value, err := CallObject(state, function, &values)
+			if err != nil {
+				return nil, false, err
+			}
+
+			return value, true, nil
+		},
+	), nil
+}
+
+// Filter lazily skips elements for which the predicate returns false,
+// the elements themselves are checked if the predicate is 'нуль'.
+func Filter(state common.State, predicate common.Value, iterable common.Value) (*IteratorInstance, error) {
+	iterator, err := GetIterator(state, iterable)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIteratorInstance(
+		"фільтрувати", func(state common.State) (common.Value, bool, error) {
+			for {
+				value, ok, err := iterator.Next(state)
+				if err != nil || !ok {
+					return nil, false, err
+				}
+
+				check := value
+				if _, ok := predicate.(NilInstance); !ok {
+					check, err = CallObject(state, predicate, &[]common.Value{value})
+					if err != nil {
+						return nil, false, err
+					}
+				}
+
+				passed, err := check.AsBool(state)
+				if err != nil {
+					return nil, false, err
+				}
+
+				if passed {
+					return value, true, nil
+				}
+			}
+		},
+	), nil
+}
+
+// Zip lazily combines elements of the iterables at the same positions
+// into lists until the shortest of them is exhausted.
+func Zip(state common.State, iterables []common.Value) (*IteratorInstance, error) {
+	iterators, err := getIterators(state, iterables)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIteratorInstance(
+		"зшити", func(state common.State) (common.Value, bool, error) {
+			if len(iterators) == 0 {
+				return nil, false, nil
+			}
+
+			values, ok, err := nextOfAll(state, iterators)
+			if err != nil || !ok {
+				return nil, false, err
+			}
+
+			list := NewListInstance()
+			list.Values = values
+			return list, true, nil
+		},
+	), nil
+}
+
+// Enumerate lazily pairs elements of the iterable with their numbers
+// counted from the start.
+func Enumerate(state common.State, iterable common.Value, start int64) (*IteratorInstance, error) {
+	iterator, err := GetIterator(state, iterable)
+	if err != nil {
+		return nil, err
+	}
+
+	number := start
+	return NewIteratorInstance(
+		"пронумерувати", func(state common.State) (common.Value, bool, error) {
+			value, ok, err := iterator.Next(state)
+			if err != nil || !ok {
+				return nil, false, err
+			}
+
+			pair := NewListInstance()
+			pair.Values = []common.Value{NewIntegerInstance(number), value}
+			number++
+			return pair, true, nil
+		},
+	), nil
+}
+
+// Reduce calls the function with the accumulated value and each of the
+// elements, the first element is the initial value if it is nil.
+func Reduce(state common.State, function common.Value, iterable common.Value, initial common.Value) (
+	common.Value,
+	error,
+) {
+	result := initial
+	err := Iterate(
+		state, iterable, func(value common.Value) error {
+			if result == nil {
+				result = value
+				return nil
+			}
+
+			var err error
+			result, err = CallObject(state, function, &[]common.Value{result, value})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, util.RuntimeError("'згорнути' отримала порожню послідовність без початкового значення")
+	}
+
+	return result, nil
+}
+
+// AnyOf checks if any element is true, or if all of them are when
+// 'all' is true. Elements after the one which decides are not taken.
+func AnyOf(state common.State, iterable common.Value, all bool) (bool, error) {
+	iterator, err := GetIterator(state, iterable)
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		value, ok, err := iterator.Next(state)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return all, nil
+		}
+
+		passed, err := value.AsBool(state)
+		if err != nil {
+			return false, err
+		}
+
+		if passed != all {
+			return !all, nil
+		}
+	}
+}
+
+// Extreme returns the first of the least elements, or the greatest ones
+// if the operator is common.GreaterOp. Elements are compared by results
+// of calling the key with each of them if it is not 'нуль'.
+func Extreme(state common.State, name string, iterable common.Value, key common.Value, op common.Operator) (
+	common.Value,
+	error,
+) {
+	var best, bestKey common.Value
+	err := Iterate(
+		state, iterable, func(value common.Value) error {
+			valueKey := value
+			if _, ok := key.(NilInstance); !ok {
+				var err error
+				valueKey, err = CallObject(state, key, &[]common.Value{value})
+				if err != nil {
+					return err
+				}
+			}
+
+			if best == nil {
+				best, bestKey = value, valueKey
+				return nil
+			}
+
+			result, err := CallBinaryOperator(state, op, valueKey, bestKey)
+			if err != nil {
+				return err
+			}
+
+			better, err := result.AsBool(state)
+			if err != nil {
+				return err
+			}
+
+			if better {
+				best, bestKey = value, valueKey
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if best == nil {
+		return nil, util.RuntimeError(fmt.Sprintf("'%s' отримала порожню послідовність", name))
+	}
+
+	return best, nil
+}
+
+// Sum adds elements to the start value.
+func Sum(state common.State, iterable common.Value, start common.Value) (common.Value, error) {
+	result := start
+	err := Iterate(
+		state, iterable, func(value common.Value) error {
+			var err error
+			result, err = CallBinaryOperator(state, common.AddOp, result, value)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Sorted returns a new list with elements of the iterable sorted in the
+// same way as by 'сортувати' method of lists.
+func Sorted(state common.State, iterable common.Value, key common.Value, reverse bool) (*ListInstance, error) {
+	list := NewListInstance()
+	err := Iterate(
+		state, iterable, func(value common.Value) error {
+			list.Values = append(list.Values, value)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := list.sort(state, key, reverse); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
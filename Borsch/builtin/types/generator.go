@@ -0,0 +1,283 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+var errGeneratorClosed = errors.New("генератор закрито")
+
+type generatorMessage struct {
+	value   common.Value
+	err     error
+	done    bool
+	closing bool
+}
+
+// GeneratorInstance lazily evaluates body of a generator function.
+// The body runs in a separate goroutine, but only one of the caller
+// and the body is active at a time: the caller waits for the next
+// yielded value, the body waits for the value sent back.
+//
+// The goroutine of a generator which is neither exhausted nor closed
+// stays blocked until the interpreter exits, so generators left in the
+// middle should be closed with 'закрити'. A loop left early closes the
+// generator only if it is returned by '__ітератор__' of the iterable.
+type GeneratorInstance struct {
+	BuiltinInstance
+	Name string
+
+	state    common.State
+	body     func(common.State) (common.Value, error)
+	resume   chan generatorMessage
+	yield    chan generatorMessage
+	started  bool
+	finished bool
+}
+
+func NewGeneratorInstance(
+	name string,
+	state common.State,
+	body func(common.State) (common.Value, error),
+) *GeneratorInstance {
+	generator := &GeneratorInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance{
+				class:      Generator,
+				attributes: map[string]common.Value{},
+				address:    "",
+			},
+		},
+		Name:   name,
+		state:  state,
+		body:   body,
+		resume: make(chan generatorMessage),
+		yield:  make(chan generatorMessage),
+	}
+
+	generator.address = fmt.Sprintf("%p", generator)
+	return generator
+}
+
+func (g *GeneratorInstance) String(common.State) (string, error) {
+	return fmt.Sprintf("<генератор '%s' з адресою %s>", g.Name, g.address), nil
+}
+
+func (g *GeneratorInstance) Representation(state common.State) (string, error) {
+	return g.String(state)
+}
+
+func (g *GeneratorInstance) AsBool(common.State) (bool, error) {
+	return true, nil
+}
+
+// Next resumes the generator body and returns the next yielded value.
+func (g *GeneratorInstance) Next(state common.State) (common.Value, bool, error) {
+	return g.Send(state, NewNilInstance())
+}
+
+// Send resumes the generator body, the value becomes a result of
+// 'видати' expression the body is suspended on.
+func (g *GeneratorInstance) Send(_ common.State, value common.Value) (common.Value, bool, error) {
+	if g.finished {
+		return nil, false, nil
+	}
+
+	if !g.started {
+		if _, ok := value.(NilInstance); !ok {
+			return nil, false, util.RuntimeError("неможливо надіслати ненульове значення у щойно створений генератор")
+		}
+
+		g.started = true
+		go g.run()
+	} else {
+		g.resume <- generatorMessage{value: value}
+	}
+
+	message := <-g.yield
+	if message.done {
+		g.finished = true
+		return nil, false, message.err
+	}
+
+	return message.value, true, nil
+}
+
+// Yield is called by the generator body to pass the value to the
+// caller and suspend until the generator is resumed.
+func (g *GeneratorInstance) Yield(_ common.State, value common.Value) (common.Value, error) {
+	g.yield <- generatorMessage{value: value}
+	message := <-g.resume
+	if message.closing {
+		return nil, errGeneratorClosed
+	}
+
+	return message.value, nil
+}
+
+// Close stops the suspended generator body.
+func (g *GeneratorInstance) Close() error {
+	if g.finished {
+		return nil
+	}
+
+	g.finished = true
+	if !g.started {
+		return nil
+	}
+
+	g.resume <- generatorMessage{closing: true}
+	message := <-g.yield
+	if message.err == errGeneratorClosed {
+		return nil
+	}
+
+	return message.err
+}
+
+// run evaluates the body, a panic in the body is passed to the caller
+// as an error instead of terminating the interpreter.
+func (g *GeneratorInstance) run() {
+	var result common.Value
+	var err error
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, util.InternalError(fmt.Sprintf("генератор '%s': %v", g.Name, r))
+		}
+
+		g.yield <- generatorMessage{value: result, err: err, done: true}
+	}()
+
+	result, err = g.body(g.state.WithGenerator(g))
+}
+
+func newGeneratorMethod(
+	name string,
+	parameters []FunctionParameter,
+	handler func(common.State, *GeneratorInstance, []common.Value) (common.Value, error),
+	returnTypes []FunctionReturnType,
+	doc string,
+) *FunctionInstance {
+	return NewFunctionInstance(
+		name,
+		append(
+			[]FunctionParameter{
+				{
+					Type:       Generator,
+					Name:       "я",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			parameters...,
+		),
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return handler(state, (*args)[0].(*GeneratorInstance), (*args)[1:])
+		},
+		returnTypes,
+		true,
+		nil,
+		doc,
+	)
+}
+
+func makeGeneratorResult(value common.Value, ok bool) common.Value {
+	if !ok {
+		value = NewNilInstance()
+	}
+
+	result := NewListInstance()
+	result.Values = []common.Value{value, NewBoolInstance(ok)}
+	return result
+}
+
+func newGeneratorClass() *Class {
+	resultTypes := []FunctionReturnType{
+		{
+			Type:       Any,
+			IsNullable: true,
+		},
+		{
+			Type:       Bool,
+			IsNullable: false,
+		},
+	}
+
+	initAttributes := func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			map[string]common.Value{
+				common.IteratorOperatorName: newUnaryMethod(
+					common.IteratorOperatorName, Generator, Generator, "Повертає сам генератор.",
+					func(_ common.State, self common.Value) (common.Value, error) {
+						return self, nil
+					},
+				),
+				"наступний": newGeneratorMethod(
+					"наступний",
+					[]FunctionParameter{},
+					func(state common.State, self *GeneratorInstance, _ []common.Value) (common.Value, error) {
+						value, ok, err := self.Next(state)
+						if err != nil {
+							return nil, err
+						}
+
+						return makeGeneratorResult(value, ok), nil
+					},
+					resultTypes,
+					"Відновлює генератор і повертає наступне значення та ознаку того, що воно є.",
+				),
+				"надіслати": newGeneratorMethod(
+					"надіслати",
+					[]FunctionParameter{
+						{
+							Type:       Any,
+							Name:       "значення",
+							IsVariadic: false,
+							IsNullable: true,
+						},
+					},
+					func(state common.State, self *GeneratorInstance, args []common.Value) (common.Value, error) {
+						value, ok, err := self.Send(state, args[0])
+						if err != nil {
+							return nil, err
+						}
+
+						return makeGeneratorResult(value, ok), nil
+					},
+					resultTypes,
+					"Відновлює генератор, передаючи значення як результат 'віддати', і повертає наступне значення та ознаку того, що воно є.",
+				),
+				"закрити": newGeneratorMethod(
+					"закрити",
+					[]FunctionParameter{},
+					func(_ common.State, self *GeneratorInstance, _ []common.Value) (common.Value, error) {
+						return NewNilInstance(), self.Close()
+					},
+					[]FunctionReturnType{
+						{
+							Type:       Nil,
+							IsNullable: false,
+						},
+					},
+					"Зупиняє генератор, після цього він більше не повертає значень.",
+				),
+			},
+			MakeLogicalOperators(Generator),
+			MakeCommonOperators(Generator),
+		)
+	}
+
+	return &Class{
+		Name:            common.GeneratorTypeName,
+		IsFinal:         true,
+		Bases:           []*Class{},
+		Parent:          BuiltinPackage,
+		AttrInitializer: initAttributes,
+		GetEmptyInstance: func() (common.Value, error) {
+			panic("unreachable")
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+var initTypes sync.Once
+
+// generatorTestState is enough for bodies which do not evaluate code.
+type generatorTestState struct {
+	common.State
+}
+
+func (s generatorTestState) WithGenerator(common.GeneratorType) common.State {
+	return s
+}
+
+func newTestGenerator(body func(common.State, *GeneratorInstance) (common.Value, error)) *GeneratorInstance {
+	initTypes.Do(Init)
+	var generator *GeneratorInstance
+	generator = NewGeneratorInstance(
+		"тест", generatorTestState{}, func(state common.State) (common.Value, error) {
+			return body(state, generator)
+		},
+	)
+
+	return generator
+}
+
+func TestGenerator_PanicInBody(t *testing.T) {
+	generator := newTestGenerator(
+		func(state common.State, g *GeneratorInstance) (common.Value, error) {
+			if _, err := g.Yield(state, NewIntegerInstance(1)); err != nil {
+				return nil, err
+			}
+
+			panic("щось зламалося")
+		},
+	)
+
+	if _, ok, err := generator.Next(nil); !ok || err != nil {
+		t.Fatalf("expected the first value, got %v, %v", ok, err)
+	}
+
+	_, ok, err := generator.Next(nil)
+	if ok || err == nil || !strings.Contains(err.Error(), "щось зламалося") {
+		t.Fatalf("expected the panic to be returned as an error, got %v, %v", ok, err)
+	}
+
+	if _, ok, err := generator.Next(nil); ok || err != nil {
+		t.Fatalf("expected the generator to be finished, got %v, %v", ok, err)
+	}
+}
+
+func TestGenerator_CloseStopsBody(t *testing.T) {
+	stopped := false
+	generator := newTestGenerator(
+		func(state common.State, g *GeneratorInstance) (common.Value, error) {
+			defer func() {
+				stopped = true
+			}()
+
+			for {
+				if _, err := g.Yield(state, NewIntegerInstance(1)); err != nil {
+					return nil, err
+				}
+			}
+		},
+	)
+
+	if _, ok, err := generator.Next(nil); !ok || err != nil {
+		t.Fatalf("expected the first value, got %v, %v", ok, err)
+	}
+
+	if err := generator.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !stopped {
+		t.Fatal("expected the body to be stopped")
+	}
+}
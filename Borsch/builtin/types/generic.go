@@ -0,0 +1,291 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// TypeArgument is a type passed to a generic class, e.g. 'цілий'
+// in 'список[цілий]'.
+type TypeArgument struct {
+	Type          *Class
+	IsNullable    bool
+	TypeArguments []TypeArgument
+}
+
+func (a TypeArgument) String() string {
+	res := typeName(a.Type, a.TypeArguments)
+	if a.IsNullable {
+		res += "?"
+	}
+
+	return res
+}
+
+func typeName(class *Class, arguments []TypeArgument) string {
+	res := common.AnyTypeName
+	if class != Any {
+		res = class.GetName()
+	}
+
+	if len(arguments) != 0 {
+		var names []string
+		for _, argument := range arguments {
+			names = append(names, argument.String())
+		}
+
+		res += "[" + strings.Join(names, ", ") + "]"
+	}
+
+	return res
+}
+
+func equalTypeArguments(a, b []TypeArgument) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for idx := range a {
+		if a[idx].Type != b[idx].Type ||
+			a[idx].IsNullable != b[idx].IsNullable ||
+			!equalTypeArguments(a[idx].TypeArguments, b[idx].TypeArguments) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewTypeParameter creates a placeholder for a type in a generic class
+// or function, it is bound to a real type during a call.
+func NewTypeParameter(name string, parent common.Value) *Class {
+	parameter := &Class{
+		Name:            name,
+		attributes:      map[string]common.Value{},
+		Bases:           []*Class{},
+		Parent:          parent,
+		isTypeParameter: true,
+	}
+
+	parameter.GetEmptyInstance = func() (common.Value, error) {
+		return nil, util.RuntimeError(
+			fmt.Sprintf("неможливо створити об'єкт параметра типу '%s'", name),
+		)
+	}
+
+	parameter.Setup()
+	return parameter
+}
+
+func (c *Class) IsTypeParameter() bool {
+	return c.isTypeParameter
+}
+
+func (c *Class) isGeneric() bool {
+	if len(c.TypeParameters) != 0 {
+		return true
+	}
+
+	for _, base := range c.Bases {
+		if base.isGeneric() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckTypeArguments checks if the class can be parametrized
+// with the arguments.
+func (c *Class) CheckTypeArguments(arguments []TypeArgument) error {
+	if c == Any || len(arguments) == 0 || len(arguments) == len(c.TypeParameters) {
+		return nil
+	}
+
+	if len(c.TypeParameters) == 0 {
+		return util.RuntimeError(fmt.Sprintf("тип '%s' не є узагальненим", c.GetName()))
+	}
+
+	return util.RuntimeError(
+		fmt.Sprintf(
+			"тип '%s' очікує параметри типу (%d), отримано (%d)",
+			c.GetName(), len(c.TypeParameters), len(arguments),
+		),
+	)
+}
+
+type pendingTypeArgument struct {
+	arguments map[*Class]*Class
+	parameter *Class
+	variable  *Class
+}
+
+// TypeBindings holds types which type parameters of generic classes
+// and functions are bound to during a call. A type parameter is bound
+// to the type of the first value checked against it, the following
+// values should be of the same type or its derivatives.
+//
+// Instances of generic classes remember types of their type parameters,
+// which are inferred by the first call of a method, usually by the
+// constructor.
+type TypeBindings struct {
+	types   map[*Class]*Class
+	pending []pendingTypeArgument
+}
+
+func NewTypeBindings() *TypeBindings {
+	return &TypeBindings{types: map[*Class]*Class{}}
+}
+
+// Get returns the type the parameter is bound to, 'довільний' if
+// it is not bound.
+func (b *TypeBindings) Get(parameter *Class) *Class {
+	if b != nil {
+		if bound, ok := b.types[parameter]; ok {
+			return bound
+		}
+	}
+
+	return Any
+}
+
+// Matches checks if the value is of the type parametrized with the
+// arguments and binds type parameters the type refers to.
+func (b *TypeBindings) Matches(value common.Value, class *Class, isNullable bool, arguments []TypeArgument) bool {
+	if class != Any && class.IsUnion() {
+		return b.matchesUnion(value, class, isNullable)
+	}
+
+	valueClass := value.(ObjectInstance).GetClass()
+	if valueClass == Nil {
+		return isNullable || class == Nil || class == Any
+	}
+
+	if class == Any {
+		return true
+	}
+
+	if class.isTypeParameter {
+		return b.bind(class, valueClass)
+	}
+
+	if valueClass != class && !valueClass.HasBase(class) {
+		return false
+	}
+
+	switch v := value.(type) {
+	case *ListInstance:
+		if len(arguments) == 0 {
+			return true
+		}
+
+		for _, element := range v.Values {
+			if !b.matchesArgument(element, arguments[0]) {
+				return false
+			}
+		}
+	case *DictionaryInstance:
+		if len(arguments) == 0 {
+			return true
+		}
+
+		for _, entry := range v.Entries() {
+			if !b.matchesArgument(entry.Key, arguments[0]) || !b.matchesArgument(entry.Value, arguments[1]) {
+				return false
+			}
+		}
+	default:
+		instanceArguments := typeArgumentsOf(value)
+		if instanceArguments == nil {
+			return true
+		}
+
+		for idx, parameter := range class.TypeParameters {
+			// inside a generic class the class itself refers
+			// to its own type parameters
+			argument := TypeArgument{Type: parameter}
+			if len(arguments) != 0 {
+				argument = arguments[idx]
+			}
+
+			bound, ok := instanceArguments[parameter]
+			if !ok {
+				if argument.Type != Any && argument.Type.isTypeParameter && b != nil {
+					b.pending = append(b.pending, pendingTypeArgument{instanceArguments, parameter, argument.Type})
+				}
+
+				continue
+			}
+
+			if !b.matchesType(bound, argument.Type) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (b *TypeBindings) matchesArgument(value common.Value, argument TypeArgument) bool {
+	return b.Matches(value, argument.Type, argument.IsNullable, argument.TypeArguments)
+}
+
+func (b *TypeBindings) matchesType(class *Class, expected *Class) bool {
+	if expected == Any || class == Any {
+		return true
+	}
+
+	if expected.isTypeParameter {
+		return b.bind(expected, class)
+	}
+
+	if expected.IsUnion() {
+		for _, member := range expected.unionMembers {
+			if b.matchesType(class, member.Type) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return class == expected || class.HasBase(expected)
+}
+
+func (b *TypeBindings) bind(parameter *Class, class *Class) bool {
+	if b == nil {
+		return true
+	}
+
+	if bound, ok := b.types[parameter]; ok {
+		return bound == Any || class == bound || class.HasBase(bound)
+	}
+
+	b.types[parameter] = class
+	return true
+}
+
+// inferInstanceArguments saves types bound during the call into
+// instances of generic classes which type parameters were not known.
+func (b *TypeBindings) inferInstanceArguments() {
+	for _, pending := range b.pending {
+		if bound, ok := b.types[pending.variable]; ok {
+			if _, ok := pending.arguments[pending.parameter]; !ok {
+				pending.arguments[pending.parameter] = bound
+			}
+		}
+	}
+
+	b.pending = nil
+}
+
+func typeArgumentsOf(value common.Value) map[*Class]*Class {
+	if instance, ok := value.(*ClassInstance); ok {
+		return instance.typeArguments
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// Hash calculates hash of the value. Values which are equal should have
+// equal hashes, so numbers with the same value are hashed the same way
+// regardless of their type.
+//
+// Lists and dictionaries can not be hashed, because they are mutable.
+// Objects of user classes are hashed by common.HashOperatorName, other
+// objects, e.g. functions and classes, are hashed by their identity.
+func Hash(state common.State, value common.Value) (uint64, error) {
+	switch v := value.(type) {
+	case NilInstance:
+		return 0, nil
+	case BoolInstance:
+		return uint64(boolToInt64(v.Value)), nil
+	case IntegerInstance:
+		return uint64(v.Value), nil
+	case RealInstance:
+		return hashReal(v.Value), nil
+	case StringInstance:
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(v.Value))
+		return h.Sum64(), nil
+	case RangeInstance:
+		// equal ranges have the same length, start and step, unless
+		// they are empty or have a single element
+		length := v.Length(state)
+		hashes := []uint64{uint64(length)}
+		if length > 0 {
+			hashes = append(hashes, uint64(v.Start))
+		}
+
+		if length > 1 {
+			hashes = append(hashes, uint64(v.Step))
+		}
+
+		return combineHashes(hashes), nil
+	case *ListInstance, *DictionaryInstance:
+		return 0, unhashableError(value)
+	case *ClassInstance:
+		operator, err := value.GetOperator(common.HashOperatorName)
+		if err != nil {
+			return 0, unhashableError(value)
+		}
+
+		return callHashOperator(state, value, operator)
+	}
+
+	if reflect.ValueOf(value).Kind() == reflect.Ptr {
+		return uint64(reflect.ValueOf(value).Pointer()), nil
+	}
+
+	return 0, unhashableError(value)
+}
+
+// hashReal hashes whole numbers as integers, because 1.0 == 1.
+func hashReal(value float64) uint64 {
+	if value == math.Trunc(value) && value >= math.MinInt64 && value < math.MaxInt64 {
+		return uint64(int64(value))
+	}
+
+	return math.Float64bits(value)
+}
+
+// combineHashes calculates hash of a sequence from hashes of its
+// elements, the order of elements matters.
+func combineHashes(hashes []uint64) uint64 {
+	h := fnv.New64a()
+	for _, hash := range hashes {
+		var bytes [8]byte
+		for i := range bytes {
+			bytes[i] = byte(hash >> (8 * i))
+		}
+
+		_, _ = h.Write(bytes[:])
+	}
+
+	return h.Sum64()
+}
+
+func callHashOperator(state common.State, value common.Value, operator common.Value) (uint64, error) {
+	result, err := CallAttribute(state, value, operator, common.HashOperatorName, nil, nil, true)
+	if err != nil {
+		return 0, err
+	}
+
+	hash, ok := result.(IntegerInstance)
+	if !ok {
+		return 0, util.RuntimeError(
+			fmt.Sprintf(
+				"'%s' має повертати значення цілого типу, отримано '%s'",
+				common.HashOperatorName, result.GetTypeName(),
+			),
+		)
+	}
+
+	return uint64(hash.Value), nil
+}
+
+func unhashableError(value common.Value) error {
+	return util.RuntimeError(fmt.Sprintf("неможливо хешувати значення типу '%s'", value.GetTypeName()))
+}
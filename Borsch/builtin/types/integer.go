@@ -81,7 +81,7 @@ func compareIntegers(_ common.State, op common.Operator, self common.Value, othe
 
 		return 1, nil
 	default:
-		return 0, util.OperatorNotSupportedError(op, left.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
@@ -148,7 +148,7 @@ func newIntegerClass() *Class {
 								),
 							), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -187,7 +187,7 @@ func newIntegerClass() *Class {
 							}
 
 							return NewStringInstance(strings.Repeat(o.Value, count)), nil
-						case ListInstance:
+						case *ListInstance:
 							count := int(self.Value)
 							list := NewListInstance()
 							if count > 0 {
@@ -198,7 +198,7 @@ func newIntegerClass() *Class {
 
 							return list, nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -219,7 +219,7 @@ func newIntegerClass() *Class {
 								return NewRealInstance(float64(self.Value) / o.Value), nil
 							}
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 
 						return nil, errors.New("ділення на нуль")
@@ -238,7 +238,7 @@ func newIntegerClass() *Class {
 								return NewIntegerInstance(self.Value % o.Value), nil
 							}
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 
 						return nil, errors.New("ділення за модулем на нуль")
@@ -255,7 +255,7 @@ func newIntegerClass() *Class {
 						case RealInstance:
 							return NewRealInstance(float64(self.Value) + o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -270,7 +270,7 @@ func newIntegerClass() *Class {
 						case RealInstance:
 							return NewRealInstance(float64(self.Value) - o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -285,7 +285,7 @@ func newIntegerClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(self.Value << o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -300,7 +300,7 @@ func newIntegerClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(self.Value >> o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -315,7 +315,7 @@ func newIntegerClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(self.Value & o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -330,7 +330,7 @@ func newIntegerClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(self.Value ^ o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -345,7 +345,7 @@ func newIntegerClass() *Class {
 						case IntegerInstance:
 							return NewIntegerInstance(self.Value | o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
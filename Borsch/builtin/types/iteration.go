@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+type sequenceIterator struct {
+	sequence common.SequentialType
+	index    int64
+}
+
+func (i *sequenceIterator) Next(state common.State) (common.Value, bool, error) {
+	if i.index >= i.sequence.Length(state) {
+		return nil, false, nil
+	}
+
+	value, err := i.sequence.GetElement(state, i.index)
+	if err != nil {
+		return nil, false, err
+	}
+
+	i.index++
+	return value, true, nil
+}
+
+type valuesIterator struct {
+	values []common.Value
+	index  int
+}
+
+func (i *valuesIterator) Next(common.State) (common.Value, bool, error) {
+	if i.index >= len(i.values) {
+		return nil, false, nil
+	}
+
+	value := i.values[i.index]
+	i.index++
+	return value, true, nil
+}
+
+// GetIterator returns an iterator over elements of the object.
+// Sequences are iterated by index, dictionaries by keys, objects
+// of other types should implement common.IteratorOperatorName.
+func GetIterator(state common.State, object common.Value) (common.IteratorType, error) {
+	switch iterable := object.(type) {
+	case common.IteratorType:
+		return iterable, nil
+	case common.SequentialType:
+		return &sequenceIterator{sequence: iterable}, nil
+	case *Class:
+		if iterable.IsEnum() {
+			iterator := &valuesIterator{}
+			for _, member := range iterable.enumMembers {
+				iterator.values = append(iterator.values, member)
+			}
+
+			return iterator, nil
+		}
+	case *DictionaryInstance:
+		iterator := &valuesIterator{}
+		for _, entry := range iterable.Entries() {
+			iterator.values = append(iterator.values, entry.Key)
+		}
+
+		return iterator, nil
+	}
+
+	if object.HasAttribute(common.IteratorOperatorName) {
+		result, err := CallByName(state, object, common.IteratorOperatorName, nil, nil, true)
+		if err != nil {
+			return nil, err
+		}
+
+		switch iterable := result.(type) {
+		case common.IteratorType:
+			return iterable, nil
+		case common.SequentialType:
+			return &sequenceIterator{sequence: iterable}, nil
+		default:
+			return nil, util.RuntimeError(
+				fmt.Sprintf(
+					"'%s' має повертати ітератор, отримано '%s'",
+					common.IteratorOperatorName, result.GetTypeName(),
+				),
+			)
+		}
+	}
+
+	return nil, util.RuntimeError(fmt.Sprintf("об'єкт типу '%s' не є ітерованим", object.GetTypeName()))
+}
+
+func drainIterator(state common.State, iterator common.IteratorType) ([]common.Value, error) {
+	var values []common.Value
+	for {
+		value, ok, err := iterator.Next(state)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return values, nil
+		}
+
+		values = append(values, value)
+	}
+}
+
+// closableIterator holds resources until it is exhausted or closed,
+// e.g. a generator suspended in the middle of its body.
+type closableIterator interface {
+	Close() error
+}
+
+// CloseIterator stops the iterator which is left before it is
+// exhausted, e.g. by 'перервати' in a loop over the iterable. Only
+// iterators created from the iterable are closed, an iterator passed
+// as the iterable itself belongs to the caller and may be resumed.
+func CloseIterator(iterable common.Value, iterator common.IteratorType) error {
+	if _, ok := iterable.(common.IteratorType); ok {
+		return nil
+	}
+
+	if closable, ok := iterator.(closableIterator); ok {
+		return closable.Close()
+	}
+
+	return nil
+}
+
+// Iterate calls handler for each element of the iterable object.
+func Iterate(state common.State, object common.Value, handler func(common.Value) error) error {
+	iterator, err := GetIterator(state, object)
+	if err != nil {
+		return err
+	}
+
+	for {
+		value, ok, err := iterator.Next(state)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return nil
+		}
+
+		if err := handler(value); err != nil {
+			_ = CloseIterator(object, iterator)
+			return err
+		}
+	}
+}
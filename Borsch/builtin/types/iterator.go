@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// IteratorInstance lazily produces values by calling 'next', it is
+// returned by built-in functions which transform iterable objects.
+type IteratorInstance struct {
+	BuiltinInstance
+	Name string
+
+	next     func(common.State) (common.Value, bool, error)
+	finished bool
+}
+
+func NewIteratorInstance(name string, next func(common.State) (common.Value, bool, error)) *IteratorInstance {
+	iterator := &IteratorInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance{
+				class:      Iterator,
+				attributes: map[string]common.Value{},
+				address:    "",
+			},
+		},
+		Name: name,
+		next: next,
+	}
+
+	iterator.address = fmt.Sprintf("%p", iterator)
+	return iterator
+}
+
+func (i *IteratorInstance) String(common.State) (string, error) {
+	return fmt.Sprintf("<ітератор '%s' з адресою %s>", i.Name, i.address), nil
+}
+
+func (i *IteratorInstance) Representation(state common.State) (string, error) {
+	return i.String(state)
+}
+
+func (i *IteratorInstance) AsBool(common.State) (bool, error) {
+	return true, nil
+}
+
+// Next returns the next value, the iterator stays exhausted after
+// the first time it has no more values.
+func (i *IteratorInstance) Next(state common.State) (common.Value, bool, error) {
+	if i.finished {
+		return nil, false, nil
+	}
+
+	value, ok, err := i.next(state)
+	if err != nil || !ok {
+		i.finished = true
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func newIteratorClass() *Class {
+	initAttributes := func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			map[string]common.Value{
+				common.IteratorOperatorName: newUnaryMethod(
+					common.IteratorOperatorName, Iterator, Iterator, "Повертає сам ітератор.",
+					func(_ common.State, self common.Value) (common.Value, error) {
+						return self, nil
+					},
+				),
+				"наступний": NewFunctionInstance(
+					"наступний",
+					[]FunctionParameter{
+						{
+							Type:       Iterator,
+							Name:       "я",
+							IsVariadic: false,
+							IsNullable: false,
+						},
+					},
+					func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+						value, ok, err := (*args)[0].(*IteratorInstance).Next(state)
+						if err != nil {
+							return nil, err
+						}
+
+						return makeGeneratorResult(value, ok), nil
+					},
+					[]FunctionReturnType{
+						{
+							Type:       Any,
+							IsNullable: true,
+						},
+						{
+							Type:       Bool,
+							IsNullable: false,
+						},
+					},
+					true,
+					nil,
+					"Повертає наступне значення та ознаку того, що воно є.",
+				),
+			},
+			MakeLogicalOperators(Iterator),
+			MakeCommonOperators(Iterator),
+		)
+	}
+
+	return &Class{
+		Name:            common.IteratorTypeName,
+		IsFinal:         true,
+		Bases:           []*Class{},
+		Parent:          BuiltinPackage,
+		AttrInitializer: initAttributes,
+		GetEmptyInstance: func() (common.Value, error) {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("неможливо створити об'єкт типу '%s'", common.IteratorTypeName),
+			)
+		},
+	}
+}
@@ -2,19 +2,22 @@ package types
 
 import (
 	"errors"
-	"strings"
+	"fmt"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
+// ListInstance is a reference object: all variables, attributes and
+// elements which hold the list refer to the same values, so changes
+// made by mutating methods are visible through every of them.
 type ListInstance struct {
 	BuiltinInstance
 	Values []common.Value
 }
 
-func NewListInstance() ListInstance {
-	return ListInstance{
+func NewListInstance() *ListInstance {
+	list := &ListInstance{
 		BuiltinInstance: BuiltinInstance{
 			ClassInstance{
 				class:      List,
@@ -24,35 +27,28 @@ func NewListInstance() ListInstance {
 		},
 		Values: []common.Value{},
 	}
+
+	list.address = fmt.Sprintf("%p", list)
+	return list
 }
 
-func (t ListInstance) String(state common.State) (string, error) {
+func (t *ListInstance) String(state common.State) (string, error) {
 	return t.Representation(state)
 }
 
-func (t ListInstance) Representation(state common.State) (string, error) {
-	var strValues []string
-	for _, value := range t.Values {
-		strValue, err := value.Representation(state)
-		if err != nil {
-			return "", err
-		}
-
-		strValues = append(strValues, strValue)
-	}
-
-	return "[" + strings.Join(strValues, ", ") + "]", nil
+func (t *ListInstance) Representation(state common.State) (string, error) {
+	return newContainerRepresentation(state).represent(t)
 }
 
-func (t ListInstance) AsBool(state common.State) (bool, error) {
+func (t *ListInstance) AsBool(state common.State) (bool, error) {
 	return t.Length(state) != 0, nil
 }
 
-func (t ListInstance) Length(common.State) int64 {
+func (t *ListInstance) Length(common.State) int64 {
 	return int64(len(t.Values))
 }
 
-func (t ListInstance) GetElement(state common.State, index int64) (common.Value, error) {
+func (t *ListInstance) GetElement(state common.State, index int64) (common.Value, error) {
 	idx, err := getIndex(index, t.Length(state))
 	if err != nil {
 		return nil, err
@@ -61,7 +57,7 @@ func (t ListInstance) GetElement(state common.State, index int64) (common.Value,
 	return t.Values[idx], nil
 }
 
-func (t ListInstance) SetElement(state common.State, index int64, value common.Value) (common.Value, error) {
+func (t *ListInstance) SetElement(state common.State, index int64, value common.Value) (common.Value, error) {
 	idx, err := getIndex(index, t.Length(state))
 	if err != nil {
 		return nil, err
@@ -71,7 +67,7 @@ func (t ListInstance) SetElement(state common.State, index int64, value common.V
 	return t, nil
 }
 
-func (t ListInstance) Slice(state common.State, from, to int64) (common.Value, error) {
+func (t *ListInstance) Slice(state common.State, from, to int64) (common.Value, error) {
 	length := t.Length(state)
 	fromIdx := normalizeBound(from, length)
 	toIdx := normalizeBound(to, length)
@@ -80,27 +76,70 @@ func (t ListInstance) Slice(state common.State, from, to int64) (common.Value, e
 	}
 
 	listInstance := NewListInstance()
-	listInstance.Values = t.Values[fromIdx:toIdx]
+	listInstance.Values = append(listInstance.Values, t.Values[fromIdx:toIdx]...)
 	return listInstance, nil
 }
 
-func compareLists(_ common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
+func compareLists(state common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
 	switch right := other.(type) {
 	case NilInstance:
-	case ListInstance:
-		return -2, util.OperandsNotSupportedError(op, self.GetTypeName(), right.GetTypeName())
+	case *ListInstance:
+		return newContainerComparison(state).compare(op, self, right)
 	default:
-		return -2, util.OperatorNotSupportedError(op, self.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
 	return -2, nil
 }
 
+// compareSequences compares elements lexicographically, the shorter
+// sequence is less if it is the beginning of the other one.
+func (c *containerComparison) compareSequences(op common.Operator, left, right []common.Value) (int, error) {
+	if (op == common.EqualsOp || op == common.NotEqualsOp) && len(left) != len(right) {
+		return -2, nil
+	}
+
+	for idx := 0; idx < len(left) && idx < len(right); idx++ {
+		equals, err := c.call(common.EqualsOp, left[idx], right[idx])
+		if err != nil {
+			return 0, err
+		}
+
+		if equals {
+			continue
+		}
+
+		if op == common.EqualsOp || op == common.NotEqualsOp {
+			return -2, nil
+		}
+
+		less, err := c.call(common.LessOp, left[idx], right[idx])
+		if err != nil {
+			return 0, err
+		}
+
+		if less {
+			return -1, nil
+		}
+
+		return 1, nil
+	}
+
+	switch {
+	case len(left) < len(right):
+		return -1, nil
+	case len(left) > len(right):
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
 func newListBinaryOperator(
 	name string,
 	doc string,
-	handler func(ListInstance, common.Value) (common.Value, error),
+	handler func(*ListInstance, common.Value) (common.Value, error),
 ) *FunctionInstance {
 	return newBinaryMethod(
 		name,
@@ -108,7 +147,7 @@ func newListBinaryOperator(
 		Any,
 		doc,
 		func(_ common.State, left common.Value, right common.Value) (common.Value, error) {
-			if leftInstance, ok := left.(ListInstance); ok {
+			if leftInstance, ok := left.(*ListInstance); ok {
 				return handler(leftInstance, right)
 			}
 
@@ -129,7 +168,7 @@ func newListClass() *Class {
 
 				common.MulOp.Name(): newListBinaryOperator(
 					// TODO: add doc
-					common.MulOp.Name(), "", func(self ListInstance, other common.Value) (common.Value, error) {
+					common.MulOp.Name(), "", func(self *ListInstance, other common.Value) (common.Value, error) {
 						switch o := other.(type) {
 						case IntegerInstance:
 							count := int(o.Value)
@@ -142,23 +181,37 @@ func newListClass() *Class {
 
 							return list, nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
 				common.AddOp.Name(): newListBinaryOperator(
 					// TODO: add doc
-					common.AddOp.Name(), "", func(self ListInstance, other common.Value) (common.Value, error) {
+					common.AddOp.Name(), "", func(self *ListInstance, other common.Value) (common.Value, error) {
 						switch o := other.(type) {
-						case ListInstance:
+						case *ListInstance:
+							list := NewListInstance()
+							list.Values = append(list.Values, self.Values...)
+							list.Values = append(list.Values, o.Values...)
+							return list, nil
+						default:
+							return NewNotImplementedInstance(), nil
+						}
+					},
+				),
+				common.AddOp.InPlaceName(): newListBinaryOperator(
+					common.AddOp.InPlaceName(), "Додає до списку елементи іншого списку.", func(self *ListInstance, other common.Value) (common.Value, error) {
+						switch o := other.(type) {
+						case *ListInstance:
 							self.Values = append(self.Values, o.Values...)
 							return self, nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
 			},
+			makeListMethods(),
 			MakeLogicalOperators(List),
 			MakeComparisonOperators(List, compareLists),
 			MakeCommonOperators(List),
@@ -171,6 +224,9 @@ func newListClass() *Class {
 		Bases:           []*Class{},
 		Parent:          BuiltinPackage,
 		AttrInitializer: initAttributes,
+		TypeParameters: []*Class{
+			NewTypeParameter("Т", BuiltinPackage),
+		},
 		GetEmptyInstance: func() (common.Value, error) {
 			return NewListInstance(), nil
 		},
@@ -0,0 +1,358 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+func newListMethod(
+	name string,
+	parameters []FunctionParameter,
+	handler func(common.State, *ListInstance, []common.Value) (common.Value, error),
+	returnType *Class,
+	doc string,
+) *FunctionInstance {
+	return NewFunctionInstance(
+		name,
+		append(
+			[]FunctionParameter{
+				{
+					Type:       List,
+					Name:       "я",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			parameters...,
+		),
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return handler(state, (*args)[0].(*ListInstance), (*args)[1:])
+		},
+		[]FunctionReturnType{
+			{
+				Type:       returnType,
+				IsNullable: returnType == Any,
+			},
+		},
+		true,
+		nil,
+		doc,
+	)
+}
+
+func newElementParameter(name string) FunctionParameter {
+	return FunctionParameter{
+		Type:       Any,
+		Name:       name,
+		IsVariadic: false,
+		IsNullable: true,
+	}
+}
+
+// indexOf returns index of the first element equal to the value,
+// or -1 if the list does not contain it.
+func (t *ListInstance) indexOf(state common.State, value common.Value) (int, error) {
+	for idx, element := range t.Values {
+		equals, err := Equals(state, element, value)
+		if err != nil {
+			return -1, err
+		}
+
+		if equals {
+			return idx, nil
+		}
+	}
+
+	return -1, nil
+}
+
+func (t *ListInstance) pop(state common.State, index int64) (common.Value, error) {
+	if len(t.Values) == 0 {
+		return nil, util.RuntimeError("неможливо вийняти елемент з порожнього списку")
+	}
+
+	idx, err := getIndex(index, t.Length(state))
+	if err != nil {
+		return nil, util.RuntimeError(err.Error())
+	}
+
+	value := t.Values[idx]
+	t.Values = append(t.Values[:idx], t.Values[idx+1:]...)
+	return value, nil
+}
+
+// sort sorts elements using common.LessOp, elements which are equal
+// keep their order. If the key is not 'нуль', elements are compared
+// by results of calling it with each of them.
+func (t *ListInstance) sort(state common.State, key common.Value, reverse bool) error {
+	keys := t.Values
+	if _, ok := key.(NilInstance); !ok {
+		keys = make([]common.Value, len(t.Values))
+		for idx, element := range t.Values {
+			value, err := CallObject(state, key, &[]common.Value{element})
+			if err != nil {
+				return err
+			}
+
+			keys[idx] = value
+		}
+	}
+
+	indexes := make([]int, len(t.Values))
+	for idx := range indexes {
+		indexes[idx] = idx
+	}
+
+	var err error
+	sort.SliceStable(
+		indexes, func(i, j int) bool {
+			if err != nil {
+				return false
+			}
+
+			left, right := keys[indexes[i]], keys[indexes[j]]
+			if reverse {
+				left, right = right, left
+			}
+
+			var result common.Value
+			result, err = CallBinaryOperator(state, common.LessOp, left, right)
+			if err != nil {
+				return false
+			}
+
+			var less bool
+			less, err = result.AsBool(state)
+			return less
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	values := make([]common.Value, len(t.Values))
+	for idx, original := range indexes {
+		values[idx] = t.Values[original]
+	}
+
+	t.Values = values
+	return nil
+}
+
+func newSortMethod(
+	parameters []FunctionParameter,
+	getArguments func([]common.Value) (common.Value, bool),
+	doc string,
+) *FunctionInstance {
+	return newListMethod(
+		"сортувати",
+		parameters,
+		func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+			key, reverse := getArguments(args)
+			return NewNilInstance(), self.sort(state, key, reverse)
+		},
+		Nil,
+		doc,
+	)
+}
+
+func makeListMethods() map[string]common.Value {
+	reverseParameter := FunctionParameter{
+		Type:       Bool,
+		Name:       "зворотний",
+		IsVariadic: false,
+		IsNullable: false,
+	}
+
+	return map[string]common.Value{
+		"додати": newListMethod(
+			"додати",
+			[]FunctionParameter{
+				{
+					Type:       Any,
+					Name:       "елементи",
+					IsVariadic: true,
+					IsNullable: true,
+				},
+			},
+			func(_ common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+				self.Values = append(self.Values, args...)
+				return NewNilInstance(), nil
+			},
+			Nil,
+			"Додає елементи в кінець списку.",
+		),
+		"вставити": newListMethod(
+			"вставити",
+			[]FunctionParameter{newIntegerParameter("індекс"), newElementParameter("елемент")},
+			func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+				// the index out of bounds means the start or the end of the list
+				idx := normalizeBound(args[0].(IntegerInstance).Value, self.Length(state))
+				if idx < 0 {
+					idx = 0
+				} else if idx > self.Length(state) {
+					idx = self.Length(state)
+				}
+
+				self.Values = append(self.Values, nil)
+				copy(self.Values[idx+1:], self.Values[idx:])
+				self.Values[idx] = args[1]
+				return NewNilInstance(), nil
+			},
+			Nil,
+			"Вставляє елемент перед заданим індексом, індекс за межами списку означає його початок або кінець.",
+		),
+		"вилучити": newListMethod(
+			"вилучити",
+			[]FunctionParameter{newElementParameter("елемент")},
+			func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+				idx, err := self.indexOf(state, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				if idx == -1 {
+					return NewBoolInstance(false), nil
+				}
+
+				self.Values = append(self.Values[:idx], self.Values[idx+1:]...)
+				return NewBoolInstance(true), nil
+			},
+			Bool,
+			"Вилучає перший елемент, що дорівнює заданому, і повертає, чи такий елемент був.",
+		),
+		"вийняти": MustOverload(
+			newListMethod(
+				"вийняти",
+				[]FunctionParameter{},
+				func(state common.State, self *ListInstance, _ []common.Value) (common.Value, error) {
+					return self.pop(state, -1)
+				},
+				Any,
+				"Вилучає й повертає останній елемент списку.",
+			),
+			newListMethod(
+				"вийняти",
+				[]FunctionParameter{newIntegerParameter("індекс")},
+				func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+					return self.pop(state, args[0].(IntegerInstance).Value)
+				},
+				Any,
+				"Вилучає й повертає елемент за індексом.",
+			),
+		),
+		"індекс": newListMethod(
+			"індекс",
+			[]FunctionParameter{newElementParameter("елемент")},
+			func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+				idx, err := self.indexOf(state, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				return NewIntegerInstance(int64(idx)), nil
+			},
+			Integer,
+			"Повертає індекс першого елемента, що дорівнює заданому, або -1.",
+		),
+		"порахувати": newListMethod(
+			"порахувати",
+			[]FunctionParameter{newElementParameter("елемент")},
+			func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+				var count int64
+				for _, element := range self.Values {
+					equals, err := Equals(state, element, args[0])
+					if err != nil {
+						return nil, err
+					}
+
+					if equals {
+						count++
+					}
+				}
+
+				return NewIntegerInstance(count), nil
+			},
+			Integer,
+			"Повертає кількість елементів, що дорівнюють заданому.",
+		),
+		"розвернути": newListMethod(
+			"розвернути",
+			[]FunctionParameter{},
+			func(_ common.State, self *ListInstance, _ []common.Value) (common.Value, error) {
+				for i, j := 0, len(self.Values)-1; i < j; i, j = i+1, j-1 {
+					self.Values[i], self.Values[j] = self.Values[j], self.Values[i]
+				}
+
+				return NewNilInstance(), nil
+			},
+			Nil,
+			"Розміщує елементи списку у зворотному порядку.",
+		),
+		"розширити": newListMethod(
+			"розширити",
+			[]FunctionParameter{newElementParameter("елементи")},
+			func(state common.State, self *ListInstance, args []common.Value) (common.Value, error) {
+				// the list may extend itself
+				var values []common.Value
+				err := Iterate(
+					state, args[0], func(value common.Value) error {
+						values = append(values, value)
+						return nil
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				self.Values = append(self.Values, values...)
+				return NewNilInstance(), nil
+			},
+			Nil,
+			"Додає в кінець списку елементи ітерованого об'єкта.",
+		),
+		"очистити": newListMethod(
+			"очистити",
+			[]FunctionParameter{},
+			func(_ common.State, self *ListInstance, _ []common.Value) (common.Value, error) {
+				self.Values = []common.Value{}
+				return NewNilInstance(), nil
+			},
+			Nil,
+			"Вилучає всі елементи списку.",
+		),
+		"сортувати": MustOverload(
+			newSortMethod(
+				[]FunctionParameter{},
+				func([]common.Value) (common.Value, bool) {
+					return NewNilInstance(), false
+				},
+				"Сортує список за зростанням.",
+			),
+			newSortMethod(
+				[]FunctionParameter{reverseParameter},
+				func(args []common.Value) (common.Value, bool) {
+					return NewNilInstance(), args[0].(BoolInstance).Value
+				},
+				"Сортує список за зростанням або, якщо зворотний, за спаданням.",
+			),
+			newSortMethod(
+				[]FunctionParameter{newElementParameter("ключ")},
+				func(args []common.Value) (common.Value, bool) {
+					return args[0], false
+				},
+				"Сортує список за значеннями, які функція ключ повертає для елементів.",
+			),
+			newSortMethod(
+				[]FunctionParameter{newElementParameter("ключ"), reverseParameter},
+				func(args []common.Value) (common.Value, bool) {
+					return args[0], args[1].(BoolInstance).Value
+				},
+				"Сортує список за значеннями функції ключ, за спаданням, якщо зворотний.",
+			),
+		),
+	}
+}
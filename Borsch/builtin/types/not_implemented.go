@@ -0,0 +1,61 @@
+package types
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+// NotImplementedInstance is returned by a binary operator when it
+// does not support the type of the other operand, so the reflected
+// operator of the other operand is tried.
+type NotImplementedInstance struct {
+	BuiltinInstance
+}
+
+func NewNotImplementedInstance() NotImplementedInstance {
+	return NotImplementedInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance: ClassInstance{
+				class:      NotImplemented,
+				attributes: map[string]common.Value{},
+				address:    "",
+			},
+		},
+	}
+}
+
+func (t NotImplementedInstance) String(common.State) (string, error) {
+	return "нереалізовано", nil
+}
+
+func (t NotImplementedInstance) Representation(state common.State) (string, error) {
+	return t.String(state)
+}
+
+func (t NotImplementedInstance) AsBool(common.State) (bool, error) {
+	return true, nil
+}
+
+func IsNotImplemented(value common.Value) bool {
+	_, ok := value.(NotImplementedInstance)
+	return ok
+}
+
+func newNotImplementedClass() *Class {
+	initAttributes := func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			MakeLogicalOperators(NotImplemented),
+			MakeCommonOperators(NotImplemented),
+		)
+	}
+
+	return &Class{
+		Name:            common.NotImplementedTypeName,
+		IsFinal:         true,
+		Bases:           []*Class{},
+		Parent:          BuiltinPackage,
+		AttrInitializer: initAttributes,
+		GetEmptyInstance: func() (common.Value, error) {
+			return NewNotImplementedInstance(), nil
+		},
+	}
+}
@@ -0,0 +1,329 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// Overload returns a function which dispatches calls to the function
+// or to the other one depending on types of arguments. The other
+// function replaces an overload with the same parameter types.
+func (i *FunctionInstance) Overload(other *FunctionInstance) (*FunctionInstance, error) {
+	if i.IsStatic != other.IsStatic || i.IsClassMethod != other.IsClassMethod {
+		return nil, util.RuntimeError(
+			fmt.Sprintf("перевантаження функції '%s' повинні мати однакові модифікатори", i.Name),
+		)
+	}
+
+	var overloads []*FunctionInstance
+	isReplaced := false
+	for _, candidate := range i.variants() {
+		if candidate.hasParametersOf(other) {
+			overloads = append(overloads, other)
+			isReplaced = true
+		} else {
+			overloads = append(overloads, candidate)
+		}
+	}
+
+	if !isReplaced {
+		overloads = append(overloads, other)
+	}
+
+	if len(overloads) == 1 {
+		return other, nil
+	}
+
+	return newDispatcher(overloads), nil
+}
+
+// Inherit returns a method which dispatches calls to the method and to
+// overloads of the inherited one which are not overridden by it. An
+// overload is overridden if there is one with the same parameters
+// except the first one. Methods with different modifiers are not
+// merged, the method hides the inherited one then.
+func (i *FunctionInstance) Inherit(inherited *FunctionInstance) *FunctionInstance {
+	if i.IsStatic != inherited.IsStatic || i.IsClassMethod != inherited.IsClassMethod {
+		return i
+	}
+
+	overloads := i.variants()
+	for _, candidate := range inherited.variants() {
+		isOverridden := false
+		for _, overload := range i.variants() {
+			if overload.overrides(candidate) {
+				isOverridden = true
+				break
+			}
+		}
+
+		if !isOverridden {
+			overloads = append(overloads, candidate)
+		}
+	}
+
+	if len(overloads) == len(i.variants()) {
+		return i
+	}
+
+	return newDispatcher(overloads)
+}
+
+// Overloads returns implementations the function dispatches calls to.
+func (i *FunctionInstance) Overloads() []*FunctionInstance {
+	return i.overloads
+}
+
+// variants returns the overloads, or the function itself if it is not
+// overloaded.
+func (i *FunctionInstance) variants() []*FunctionInstance {
+	if len(i.overloads) == 0 {
+		return []*FunctionInstance{i}
+	}
+
+	return i.overloads
+}
+
+// newDispatcher creates a function which accepts any arguments and
+// passes them to the most specific of the overloads, its own
+// parameters and return types are not checked.
+func newDispatcher(overloads []*FunctionInstance) *FunctionInstance {
+	dispatcher := *overloads[len(overloads)-1]
+	dispatcher.Parameters = []FunctionParameter{
+		{
+			Type:       Any,
+			Name:       "аргументи",
+			IsVariadic: true,
+			IsNullable: true,
+		},
+	}
+	dispatcher.ReturnTypes = []FunctionReturnType{
+		{
+			Type:       Any,
+			IsNullable: true,
+		},
+	}
+	dispatcher.overloads = overloads
+	dispatcher.IsAbstract = false
+	for _, overload := range overloads {
+		dispatcher.IsAbstract = dispatcher.IsAbstract || overload.IsAbstract
+	}
+
+	dispatcher.address = fmt.Sprintf("%p", &dispatcher)
+	return &dispatcher
+}
+
+// overrides checks if the method accepts the same arguments as the
+// other one, the instances and classes the methods are bound to are
+// not compared.
+func (i *FunctionInstance) overrides(other *FunctionInstance) bool {
+	if len(i.Parameters) != len(other.Parameters) {
+		return false
+	}
+
+	first := 0
+	if !i.IsStatic && len(i.Parameters) != 0 {
+		first = 1
+	}
+
+	for idx := first; idx < len(i.Parameters); idx++ {
+		if !i.Parameters[idx].equals(other.Parameters[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (i *FunctionInstance) hasParametersOf(other *FunctionInstance) bool {
+	if len(i.Parameters) != len(other.Parameters) {
+		return false
+	}
+
+	for idx, parameter := range i.Parameters {
+		if !parameter.equals(other.Parameters[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveOverload selects the most specific overload which accepts
+// the arguments. An overload is more specific than another one if
+// each of its parameters is of the same type or a derived one.
+func (i *FunctionInstance) resolveOverload(args []common.Value) (*FunctionInstance, error) {
+	if len(i.overloads) == 0 {
+		return i, nil
+	}
+
+	var applicable []*FunctionInstance
+	for _, candidate := range i.overloads {
+		if checkArguments(candidate, args, NewTypeBindings()) == nil {
+			applicable = append(applicable, candidate)
+		}
+	}
+
+	if len(applicable) == 0 {
+		return nil, util.RuntimeError(
+			fmt.Sprintf(
+				"жоден з варіантів функції '%s' не приймає аргументи з типами (%s), доступні варіанти:%s",
+				i.Name, getTypeNames(args), listSignatures(i.overloads),
+			),
+		)
+	}
+
+	var mostSpecific []*FunctionInstance
+	for _, candidate := range applicable {
+		isDominated := false
+		for _, other := range applicable {
+			if other != candidate &&
+				other.isAsSpecificAs(candidate, len(args)) &&
+				!candidate.isAsSpecificAs(other, len(args)) {
+				isDominated = true
+				break
+			}
+		}
+
+		if !isDominated {
+			mostSpecific = append(mostSpecific, candidate)
+		}
+	}
+
+	if len(mostSpecific) != 1 {
+		return nil, util.RuntimeError(
+			fmt.Sprintf(
+				"неоднозначний виклик функції '%s' з аргументами типів (%s), підходять варіанти:%s",
+				i.Name, getTypeNames(args), listSignatures(mostSpecific),
+			),
+		)
+	}
+
+	return mostSpecific[0], nil
+}
+
+func (i *FunctionInstance) isAsSpecificAs(other *FunctionInstance, argsLen int) bool {
+	for idx := 0; idx < argsLen; idx++ {
+		if !isSubtypeParameter(i.parameterAt(idx), other.parameterAt(idx)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parameterAt returns the parameter which receives the argument
+// at the position.
+func (i *FunctionInstance) parameterAt(idx int) FunctionParameter {
+	if idx >= len(i.Parameters)-1 {
+		return i.Parameters[len(i.Parameters)-1]
+	}
+
+	return i.Parameters[idx]
+}
+
+func isSubtypeParameter(parameter, other FunctionParameter) bool {
+	if parameter.IsNullable && !other.IsNullable {
+		return false
+	}
+
+	if !isSubtype(parameter.Type, other.Type) {
+		return false
+	}
+
+	if parameter.Type != other.Type || len(other.TypeArguments) == 0 {
+		return true
+	}
+
+	if len(parameter.TypeArguments) != len(other.TypeArguments) {
+		return false
+	}
+
+	for idx, argument := range parameter.TypeArguments {
+		if !isSubtype(argument.Type, other.TypeArguments[idx].Type) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSubtype(class, other *Class) bool {
+	if other == Any {
+		return true
+	}
+
+	if class == Any {
+		return false
+	}
+
+	if other.isTypeParameter {
+		return true
+	}
+
+	if class.isTypeParameter {
+		return false
+	}
+
+	if class.IsUnion() {
+		for _, member := range class.unionMembers {
+			if !isSubtype(member.Type, other) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if other.IsUnion() {
+		for _, member := range other.unionMembers {
+			if isSubtype(class, member.Type) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return class == other || class.HasBase(other)
+}
+
+func (i *FunctionInstance) signature() string {
+	var parameters []string
+	for _, parameter := range i.Parameters {
+		parameters = append(parameters, parameter.String())
+	}
+
+	var returnTypes []string
+	for _, returnType := range i.ReturnTypes {
+		returnTypes = append(returnTypes, returnType.String())
+	}
+
+	result := fmt.Sprintf("%s(%s)", i.Name, strings.Join(parameters, ", "))
+	if len(returnTypes) == 1 {
+		return result + ": " + returnTypes[0]
+	}
+
+	return fmt.Sprintf("%s: (%s)", result, strings.Join(returnTypes, ", "))
+}
+
+func listSignatures(functions []*FunctionInstance) string {
+	result := ""
+	for _, function := range functions {
+		result += "\n  " + function.signature()
+	}
+
+	return result
+}
+
+func getTypeNames(values []common.Value) string {
+	var names []string
+	for _, value := range values {
+		names = append(names, value.GetTypeName())
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -12,6 +12,10 @@ type PackageInstance struct {
 	Name   string
 	Parent *PackageInstance
 
+	// Collation is the order used by comparison operators of strings
+	// in the package, see CollationOf.
+	Collation Collation
+
 	ctx common.Context
 }
 
@@ -25,6 +29,7 @@ func NewPackageInstance(
 		ClassInstance: *NewClassInstance(Package, attributes),
 		Name:          name,
 		Parent:        parent,
+		Collation:     DefaultCollation,
 		ctx:           ctx,
 	}
 
@@ -48,6 +53,14 @@ func (p *PackageInstance) SetContext(ctx common.Context) {
 	p.ctx = ctx
 }
 
+func (p *PackageInstance) SetAttribute(state common.State, name string, value common.Value) error {
+	if p.ctx != nil && p.ctx.IsConstant(name) {
+		return util.AttributeIsReadOnlyError(p.GetTypeName(), name)
+	}
+
+	return p.ClassInstance.SetAttribute(state, name, value)
+}
+
 func (p *PackageInstance) SetAttributes(attrs map[string]common.Value) {
 	p.attributes = attrs
 	if p.attributes == nil {
@@ -56,12 +69,16 @@ func (p *PackageInstance) SetAttributes(attrs map[string]common.Value) {
 }
 
 func comparePackages(_ common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
-	switch right := other.(type) {
+	switch other.(type) {
 	case NilInstance:
 	case *PackageInstance:
-		return -2, util.OperandsNotSupportedError(op, self.GetTypeName(), right.GetTypeName())
+		if self == other {
+			return 0, nil
+		}
+
+		return notComparable, nil
 	default:
-		return -2, util.OperatorNotSupportedError(op, self.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
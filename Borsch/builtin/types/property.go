@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// PropertyInstance is a computed attribute of a class: reading and
+// writing of the attribute of an instance call getter and setter.
+type PropertyInstance struct {
+	BuiltinInstance
+	Name   string
+	Getter common.Value
+	Setter common.Value
+}
+
+func NewPropertyInstance(name string) *PropertyInstance {
+	property := &PropertyInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance{
+				class:      Property,
+				attributes: map[string]common.Value{},
+				address:    "",
+			},
+		},
+		Name: name,
+	}
+
+	property.address = fmt.Sprintf("%p", property)
+	return property
+}
+
+func (p *PropertyInstance) String(common.State) (string, error) {
+	return fmt.Sprintf("<властивість '%s' з адресою %s>", p.Name, p.address), nil
+}
+
+func (p *PropertyInstance) Representation(state common.State) (string, error) {
+	return p.String(state)
+}
+
+func (p *PropertyInstance) AsBool(common.State) (bool, error) {
+	return true, nil
+}
+
+// Get calls getter of the property for the instance.
+func (p *PropertyInstance) Get(state common.State, instance common.Value) (common.Value, error) {
+	if p.Getter == nil {
+		return nil, util.RuntimeError(fmt.Sprintf("властивість '%s' недоступна для читання", p.Name))
+	}
+
+	return CallObject(state, p.Getter, &[]common.Value{instance})
+}
+
+// Set calls setter of the property for the instance.
+func (p *PropertyInstance) Set(state common.State, instance, value common.Value) error {
+	if p.Setter == nil {
+		return util.AttributeIsReadOnlyError(instance.GetTypeName(), p.Name)
+	}
+
+	_, err := CallObject(state, p.Setter, &[]common.Value{instance, value})
+	return err
+}
+
+func newPropertyClass() *Class {
+	initAttributes := func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			MakeLogicalOperators(Property),
+			MakeCommonOperators(Property),
+		)
+	}
+
+	return &Class{
+		Name:            common.PropertyTypeName,
+		IsFinal:         true,
+		Bases:           []*Class{},
+		Parent:          BuiltinPackage,
+		AttrInitializer: initAttributes,
+		GetEmptyInstance: func() (common.Value, error) {
+			panic("unreachable")
+		},
+	}
+}
@@ -0,0 +1,265 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// RangeInstance is an arithmetic progression of integers from 'Start'
+// to 'End' exclusively. Elements are calculated when they are accessed,
+// so the range takes the same memory regardless of its length.
+type RangeInstance struct {
+	BuiltinInstance
+	Start int64
+	End   int64
+	Step  int64
+}
+
+func NewRangeInstance(start, end, step int64) RangeInstance {
+	return RangeInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance: ClassInstance{
+				class:      Range,
+				attributes: map[string]common.Value{},
+				address:    "",
+			},
+		},
+		Start: start,
+		End:   end,
+		Step:  step,
+	}
+}
+
+func (t RangeInstance) String(state common.State) (string, error) {
+	return t.Representation(state)
+}
+
+func (t RangeInstance) Representation(common.State) (string, error) {
+	if t.Step == 1 {
+		return fmt.Sprintf("%s(%d, %d)", common.RangeTypeName, t.Start, t.End), nil
+	}
+
+	return fmt.Sprintf("%s(%d, %d, %d)", common.RangeTypeName, t.Start, t.End, t.Step), nil
+}
+
+func (t RangeInstance) AsBool(state common.State) (bool, error) {
+	return t.Length(state) != 0, nil
+}
+
+func (t RangeInstance) Length(common.State) int64 {
+	return int64(t.length())
+}
+
+// length is calculated in unsigned integers, so the distance between
+// bounds does not overflow. ToRange rejects ranges which have more
+// elements than int64 holds.
+func (t RangeInstance) length() uint64 {
+	switch {
+	case t.Step > 0 && t.Start < t.End:
+		return (uint64(t.End)-uint64(t.Start)-1)/uint64(t.Step) + 1
+	case t.Step < 0 && t.Start > t.End:
+		return (uint64(t.Start)-uint64(t.End)-1)/uint64(-t.Step) + 1
+	}
+
+	return 0
+}
+
+func (t RangeInstance) GetElement(state common.State, index int64) (common.Value, error) {
+	idx, err := getIndex(index, t.Length(state))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIntegerInstance(t.Start + idx*t.Step), nil
+}
+
+func (t RangeInstance) SetElement(common.State, int64, common.Value) (common.Value, error) {
+	return nil, errors.New("неможливо змінити елемент діапазону")
+}
+
+// Slice returns a range of elements from 'from' to 'to' index, bounds
+// out of the range are limited by its length.
+func (t RangeInstance) Slice(state common.State, from, to int64) (common.Value, error) {
+	length := t.Length(state)
+	fromIdx := clampBound(normalizeBound(from, length), length)
+	toIdx := clampBound(normalizeBound(to, length), length)
+	if fromIdx > toIdx {
+		return nil, errors.New("індекс діапазону за межами послідовності")
+	}
+
+	// indexes less than the length refer to elements, the position after
+	// the last element may be out of int64, so the end is used instead
+	start, end := t.End, t.End
+	if fromIdx < length {
+		start = t.Start + fromIdx*t.Step
+	}
+
+	if toIdx < length {
+		end = t.Start + toIdx*t.Step
+	}
+
+	return NewRangeInstance(start, end, t.Step), nil
+}
+
+// contains checks if the value is an element of the range without
+// iterating over it.
+func (t RangeInstance) contains(state common.State, value common.Value) bool {
+	var number int64
+	switch v := value.(type) {
+	case IntegerInstance:
+		number = v.Value
+	case RealInstance:
+		if v.Value != float64(int64(v.Value)) {
+			return false
+		}
+
+		number = int64(v.Value)
+	default:
+		return false
+	}
+
+	switch {
+	case t.Step > 0 && number >= t.Start && number < t.End:
+		return (uint64(number)-uint64(t.Start))%uint64(t.Step) == 0
+	case t.Step < 0 && number <= t.Start && number > t.End:
+		return (uint64(t.Start)-uint64(number))%uint64(-t.Step) == 0
+	}
+
+	return false
+}
+
+func clampBound(bound, length int64) int64 {
+	if bound < 0 {
+		return 0
+	}
+
+	if bound > length {
+		return length
+	}
+
+	return bound
+}
+
+// ToRange creates a range from the end, the start and the end, or the
+// start, the end and the step, which should not be zero.
+func ToRange(_ common.State, args ...common.Value) (common.Value, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, util.RuntimeError(
+			fmt.Sprintf("'діапазон()' приймає від одного до трьох аргументів (отримано %d)", len(args)),
+		)
+	}
+
+	var bounds []int64
+	for _, arg := range args {
+		integer, ok := arg.(IntegerInstance)
+		if !ok {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("аргументи 'діапазон()' мають бути цілого типу, отримано '%s'", arg.GetTypeName()),
+			)
+		}
+
+		bounds = append(bounds, integer.Value)
+	}
+
+	switch len(bounds) {
+	case 1:
+		return newRange(0, bounds[0], 1)
+	case 2:
+		return newRange(bounds[0], bounds[1], 1)
+	}
+
+	if bounds[2] == 0 {
+		return nil, util.RuntimeError("крок діапазону не може дорівнювати нулю")
+	}
+
+	return newRange(bounds[0], bounds[1], bounds[2])
+}
+
+func newRange(start, end, step int64) (common.Value, error) {
+	rangeInstance := NewRangeInstance(start, end, step)
+	if rangeInstance.length() > math.MaxInt64 {
+		return nil, util.RuntimeError(
+			fmt.Sprintf("діапазон містить забагато елементів, максимальна кількість — %d", int64(math.MaxInt64)),
+		)
+	}
+
+	return rangeInstance, nil
+}
+
+// compareRanges checks if ranges have the same elements, ranges are
+// not ordered.
+func compareRanges(state common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
+	left := self.(RangeInstance)
+	right, ok := other.(RangeInstance)
+	if !ok || op != common.EqualsOp && op != common.NotEqualsOp {
+		return notComparable, nil
+	}
+
+	length := left.Length(state)
+	if length != right.Length(state) ||
+		length > 0 && left.Start != right.Start ||
+		length > 1 && left.Step != right.Step {
+		// -2 is something other than -1, 0 or 1 and means 'not equals'
+		return -2, nil
+	}
+
+	return 0, nil
+}
+
+func newRangeClass() *Class {
+	initAttributes := func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			map[string]common.Value{
+				common.ConstructorName: newBuiltinConstructor(
+					Range,
+					ToRange,
+					"Створює діапазон цілих чисел від початку (типово 0) до кінця, не включаючи його, "+
+						"з кроком (типово 1).",
+				),
+				common.LengthOperatorName: newLengthOperator(Range, getLength, "Повертає кількість чисел у діапазоні."),
+				"містить": NewFunctionInstance(
+					"містить",
+					[]FunctionParameter{
+						{
+							Type:       Range,
+							Name:       "я",
+							IsVariadic: false,
+							IsNullable: false,
+						},
+						newElementParameter("значення"),
+					},
+					func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+						return NewBoolInstance((*args)[0].(RangeInstance).contains(state, (*args)[1])), nil
+					},
+					[]FunctionReturnType{
+						{
+							Type:       Bool,
+							IsNullable: false,
+						},
+					},
+					true,
+					nil,
+					"Перевіряє, чи значення є одним із чисел діапазону.",
+				),
+			},
+			MakeLogicalOperators(Range),
+			MakeComparisonOperators(Range, compareRanges),
+			MakeCommonOperators(Range),
+		)
+	}
+
+	return &Class{
+		Name:            common.RangeTypeName,
+		IsFinal:         true,
+		Bases:           []*Class{},
+		Parent:          BuiltinPackage,
+		AttrInitializer: initAttributes,
+		GetEmptyInstance: func() (common.Value, error) {
+			return NewRangeInstance(0, 0, 1), nil
+		},
+	}
+}
@@ -80,7 +80,7 @@ func compareReals(_ common.State, op common.Operator, self, other common.Value)
 
 		return 1, nil
 	default:
-		return 0, util.OperatorNotSupportedError(op, left.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
@@ -140,7 +140,7 @@ func newRealClass() *Class {
 						case BoolInstance:
 							return NewRealInstance(math.Pow(self.Value, boolToFloat64(o.Value))), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -167,7 +167,7 @@ func newRealClass() *Class {
 						case RealInstance:
 							return NewRealInstance(self.Value * o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -188,7 +188,7 @@ func newRealClass() *Class {
 								return NewRealInstance(self.Value / o.Value), nil
 							}
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 
 						return nil, errors.New("ділення на нуль")
@@ -205,7 +205,7 @@ func newRealClass() *Class {
 						case RealInstance:
 							return NewRealInstance(self.Value + o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -220,7 +220,7 @@ func newRealClass() *Class {
 						case RealInstance:
 							return NewRealInstance(self.Value - o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -0,0 +1,242 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// MakeRecordAttributes generates constructor, comparison operators,
+// hashing, representation and 'копія' method of a record class from
+// its fields. The class should freeze the fields, so the hash of a
+// record does not change.
+func MakeRecordAttributes(record *Class, fields []FunctionParameter) map[string]common.Value {
+	representation := newUnaryMethod(
+		common.RepresentationOperatorName, record, String, "Повертає назву запису та значення його полів.",
+		func(state common.State, self common.Value) (common.Value, error) {
+			var fieldStrings []string
+			for _, field := range fields {
+				value, err := self.GetAttribute(state, field.Name)
+				if err != nil {
+					return nil, err
+				}
+
+				valueRepresentation, err := value.Representation(state)
+				if err != nil {
+					return nil, err
+				}
+
+				fieldStrings = append(fieldStrings, fmt.Sprintf("%s=%s", field.Name, valueRepresentation))
+			}
+
+			return NewStringInstance(
+				fmt.Sprintf("%s(%s)", record.GetName(), strings.Join(fieldStrings, ", ")),
+			), nil
+		},
+	)
+
+	return MergeAttributes(
+		map[string]common.Value{
+			common.ConstructorName: NewFunctionInstance(
+				common.ConstructorName,
+				append(
+					[]FunctionParameter{
+						{
+							Type:       record,
+							Name:       "я",
+							IsVariadic: false,
+							IsNullable: false,
+						},
+					},
+					fields...,
+				),
+				func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+					// fields are read-only, so they are set directly,
+					// arguments are already checked against their types
+					self, ok := (*args)[0].(*ClassInstance)
+					if !ok {
+						return nil, util.IncorrectUseOfFunctionError(common.ConstructorName)
+					}
+
+					for i, field := range fields {
+						self.attributes[field.Name] = (*args)[i+1]
+					}
+
+					return NewNilInstance(), nil
+				},
+				[]FunctionReturnType{
+					{
+						Type:       Nil,
+						IsNullable: false,
+					},
+				},
+				true,
+				nil,
+				"Створює запис зі значеннями полів у порядку їх оголошення.",
+			),
+			common.RepresentationOperatorName: representation,
+			common.StringOperatorName:         representation,
+			common.HashOperatorName: newUnaryMethod(
+				common.HashOperatorName, record, Integer, "Обчислює хеш запису зі значень його полів.",
+				func(state common.State, self common.Value) (common.Value, error) {
+					var hashes []uint64
+					for _, field := range fields {
+						value, err := self.GetAttribute(state, field.Name)
+						if err != nil {
+							return nil, err
+						}
+
+						hash, err := Hash(state, value)
+						if err != nil {
+							return nil, err
+						}
+
+						hashes = append(hashes, hash)
+					}
+
+					return NewIntegerInstance(int64(combineHashes(hashes))), nil
+				},
+			),
+			"копія": NewFunctionInstance(
+				"копія",
+				[]FunctionParameter{
+					{
+						Type:       record,
+						Name:       "я",
+						IsVariadic: false,
+						IsNullable: false,
+					},
+					{
+						Type:       Dictionary,
+						Name:       "зміни",
+						IsVariadic: true,
+						IsNullable: false,
+					},
+				},
+				func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+					instance := (*args)[0].(ObjectInstance)
+					var record *ClassInstance
+					switch self := instance.(type) {
+					case *ClassInstance:
+						record = self.Copy()
+					case ClassInstance:
+						record = self.Copy()
+					default:
+						return nil, util.IncorrectUseOfFunctionError("копія")
+					}
+
+					for _, changes := range (*args)[1:] {
+						for _, entry := range changes.(*DictionaryInstance).Entries() {
+							if err := setRecordField(state, record, fields, entry.Key, entry.Value); err != nil {
+								return nil, err
+							}
+						}
+					}
+
+					return record, nil
+				},
+				[]FunctionReturnType{
+					{
+						Type:       record,
+						IsNullable: false,
+					},
+				},
+				true,
+				nil,
+				"Повертає копію запису, в якій поля зі словника змін мають нові значення.",
+			),
+		},
+		MakeComparisonOperators(record, makeRecordComparator(fields)),
+		MakeLogicalOperators(record),
+		MakeCommonOperators(record),
+	)
+}
+
+func setRecordField(
+	state common.State,
+	record *ClassInstance,
+	fields []FunctionParameter,
+	key common.Value,
+	value common.Value,
+) error {
+	name, ok := key.(StringInstance)
+	if !ok {
+		return util.RuntimeError(
+			fmt.Sprintf("назва поля запису має бути рядком, отримано '%s'", key.GetTypeName()),
+		)
+	}
+
+	for _, field := range fields {
+		if field.Name == name.Value {
+			if err := checkArgument(&field, value, false, nil); err != nil {
+				return err
+			}
+
+			record.attributes[field.Name] = value
+			return nil
+		}
+	}
+
+	return util.RuntimeError(
+		fmt.Sprintf("запис '%s' не містить поля '%s'", record.GetTypeName(), name.Value),
+	)
+}
+
+// makeRecordComparator compares records field by field in order of
+// declaration.
+func makeRecordComparator(
+	fields []FunctionParameter,
+) func(common.State, common.Operator, common.Value, common.Value) (int, error) {
+	return func(state common.State, op common.Operator, self common.Value, other common.Value) (int, error) {
+		right, ok := other.(ObjectInstance)
+		if !ok || right.GetClass() != self.(ObjectInstance).GetClass() {
+			return notComparable, nil
+		}
+
+		for _, field := range fields {
+			leftValue, err := self.GetAttribute(state, field.Name)
+			if err != nil {
+				return 0, err
+			}
+
+			rightValue, err := other.GetAttribute(state, field.Name)
+			if err != nil {
+				return 0, err
+			}
+
+			equals, err := Equals(state, leftValue, rightValue)
+			if err != nil {
+				return 0, err
+			}
+
+			if equals {
+				continue
+			}
+
+			switch op {
+			case common.EqualsOp, common.NotEqualsOp:
+				return -2, nil
+			}
+
+			result, err := CallBinaryOperator(state, common.LessOp, leftValue, rightValue)
+			if err != nil {
+				return 0, err
+			}
+
+			less, err := result.AsBool(state)
+			if err != nil {
+				return 0, err
+			}
+
+			if less {
+				return -1, nil
+			}
+
+			return 1, nil
+		}
+
+		return 0, nil
+	}
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// getClasses returns the class, or classes of the list, which is passed
+// as the argument of the function.
+func getClasses(functionName string, value common.Value) ([]*Class, error) {
+	switch v := value.(type) {
+	case *Class:
+		return []*Class{v}, nil
+	case *ListInstance:
+		var classes []*Class
+		for _, element := range v.Values {
+			class, ok := element.(*Class)
+			if !ok {
+				return nil, classExpectedError(functionName, element)
+			}
+
+			classes = append(classes, class)
+		}
+
+		return classes, nil
+	}
+
+	return nil, classExpectedError(functionName, value)
+}
+
+func classExpectedError(functionName string, value common.Value) error {
+	return util.RuntimeError(
+		fmt.Sprintf(
+			"'%s' очікує тип або список типів, отримано '%s'",
+			functionName, value.GetTypeName(),
+		),
+	)
+}
+
+// IsInstance checks if the value is of one of the classes or of their
+// subclasses, the classes are passed as a class or as a list of them.
+func IsInstance(value common.Value, classes common.Value) (bool, error) {
+	candidates, err := getClasses("є_екземпляром", classes)
+	if err != nil {
+		return false, err
+	}
+
+	var bindings *TypeBindings
+	for _, class := range candidates {
+		if bindings.Matches(value, class, false, nil) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// IsSubclass checks if the class is one of the bases or is derived
+// from one of them.
+func IsSubclass(class common.Value, bases common.Value) (bool, error) {
+	cls, ok := class.(*Class)
+	if !ok {
+		return false, classExpectedError("є_підкласом", class)
+	}
+
+	candidates, err := getClasses("є_підкласом", bases)
+	if err != nil {
+		return false, err
+	}
+
+	for _, base := range candidates {
+		if isSubtype(cls, base) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetAttributeOrDefault returns the default value if the object has no
+// attribute with the name.
+func GetAttributeOrDefault(state common.State, value common.Value, name string, defaultValue common.Value) (
+	common.Value,
+	error,
+) {
+	if !value.HasAttribute(name) {
+		return defaultValue, nil
+	}
+
+	return value.GetAttribute(state, name)
+}
+
+// DeleteAttribute removes the attribute from the object itself, the
+// attributes of its class and of built-in objects can not be removed.
+func DeleteAttribute(value common.Value, name string) error {
+	var attributes map[string]common.Value
+	var class *Class
+	switch v := value.(type) {
+	case *ClassInstance:
+		attributes, class = v.attributes, v.GetClass()
+	case *Class:
+		if !v.isType() {
+			attributes, class = v.attributes, v
+		}
+	}
+
+	if _, ok := attributes[name]; !ok || class.IsFrozenAttribute(name) {
+		if value.HasAttribute(name) {
+			return util.AttributeIsReadOnlyError(value.GetTypeName(), name)
+		}
+
+		return util.AttributeNotFoundError(value.GetTypeName(), name)
+	}
+
+	delete(attributes, name)
+	return nil
+}
+
+// Attributes returns sorted names of attributes which can be accessed
+// through the object.
+func Attributes(value common.Value) *ListInstance {
+	names := map[string]bool{}
+	if object, ok := value.(interface{ attributeNames(map[string]bool) }); ok {
+		object.attributeNames(names)
+	}
+
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+
+	sort.Strings(sorted)
+	list := NewListInstance()
+	for _, name := range sorted {
+		list.Values = append(list.Values, NewStringInstance(name))
+	}
+
+	return list
+}
+
+func newDictionaryFrom(state common.State, keys []string, values []common.Value) (*DictionaryInstance, error) {
+	dict := NewDictionaryInstance()
+	for idx, key := range keys {
+		if err := dict.SetElement(state, NewStringInstance(key), values[idx]); err != nil {
+			return nil, err
+		}
+	}
+
+	return dict, nil
+}
+
+// ParametersOf describes each of the function parameters by a dictionary
+// with its name, type, name of the type, nullability and variadicity.
+// Overloaded functions are described by 'перевантаження' instead.
+func ParametersOf(state common.State, function *FunctionInstance) (*ListInstance, error) {
+	if err := checkNotOverloaded(function); err != nil {
+		return nil, err
+	}
+
+	list := NewListInstance()
+	for _, parameter := range function.Parameters {
+		dict, err := newDictionaryFrom(
+			state,
+			[]string{"назва", "тип", "назва_типу", "може_бути_нулем", "варіативний"},
+			[]common.Value{
+				NewStringInstance(parameter.Name),
+				parameter.Type,
+				NewStringInstance(typeName(parameter.Type, parameter.TypeArguments)),
+				NewBoolInstance(parameter.IsNullable),
+				NewBoolInstance(parameter.IsVariadic),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Values = append(list.Values, dict)
+	}
+
+	return list, nil
+}
+
+// ReturnTypesOf describes each of the function return types by a
+// dictionary with the type, its name and nullability.
+func ReturnTypesOf(state common.State, function *FunctionInstance) (*ListInstance, error) {
+	if err := checkNotOverloaded(function); err != nil {
+		return nil, err
+	}
+
+	list := NewListInstance()
+	for _, returnType := range function.ReturnTypes {
+		dict, err := newDictionaryFrom(
+			state,
+			[]string{"тип", "назва_типу", "може_бути_нулем"},
+			[]common.Value{
+				returnType.Type,
+				NewStringInstance(returnType.GetTypeName()),
+				NewBoolInstance(returnType.IsNullable),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Values = append(list.Values, dict)
+	}
+
+	return list, nil
+}
+
+// OverloadsOf returns implementations the function dispatches calls to,
+// or the function itself if it is not overloaded.
+func OverloadsOf(function *FunctionInstance) *ListInstance {
+	list := NewListInstance()
+	for _, overload := range function.variants() {
+		list.Values = append(list.Values, overload)
+	}
+
+	return list
+}
+
+// checkNotOverloaded rejects overloaded functions, since each overload
+// has its own parameters and return types.
+func checkNotOverloaded(function *FunctionInstance) error {
+	if len(function.overloads) != 0 {
+		return util.RuntimeError(
+			fmt.Sprintf(
+				"функція '%s' перевантажена, отримайте її варіанти за допомогою 'перевантаження'",
+				function.Name,
+			),
+		)
+	}
+
+	return nil
+}
@@ -89,10 +89,10 @@ func (t StringInstance) Slice(state common.State, from, to int64) (common.Value,
 		return nil, errors.New("індекс рядка за межами послідовності")
 	}
 
-	return NewStringInstance(t.Value[fromIdx:toIdx]), nil
+	return NewStringInstance(string([]rune(t.Value)[fromIdx:toIdx])), nil
 }
 
-func compareStrings(_ common.State, op common.Operator, self, other common.Value) (int, error) {
+func compareStrings(state common.State, op common.Operator, self, other common.Value) (int, error) {
 	left, ok := self.(StringInstance)
 	if !ok {
 		return 0, util.IncorrectUseOfFunctionError("compareStrings")
@@ -105,13 +105,15 @@ func compareStrings(_ common.State, op common.Operator, self, other common.Value
 			return 0, nil
 		}
 
-		if left.Value < right.Value {
-			return -1, nil
+		// different strings may go together in the case-insensitive
+		// order, but they are still not equal
+		if op == common.EqualsOp || op == common.NotEqualsOp {
+			return -2, nil
 		}
 
-		return 1, nil
+		return CollationOf(state).Compare(left.Value, right.Value), nil
 	default:
-		return 0, util.OperatorNotSupportedError(op, left.GetTypeName(), right.GetTypeName())
+		return notComparable, nil
 	}
 
 	// -2 is something other than -1, 0 or 1 and means 'not equals'
@@ -144,6 +146,9 @@ func newStringClass() *Class {
 			map[string]common.Value{
 				// TODO: add doc
 				common.ConstructorName: newBuiltinConstructor(String, ToString, ""),
+
+				common.LengthOperatorName: newLengthOperator(String, getLength, "Повертає кількість символів у рядку."),
+
 				common.MulOp.Name(): newStringBinaryOperator(
 					// TODO: add doc
 					common.MulOp.Name(), "", func(self StringInstance, other common.Value) (common.Value, error) {
@@ -156,7 +161,7 @@ func newStringClass() *Class {
 
 							return NewStringInstance(strings.Repeat(self.Value, count)), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
@@ -167,11 +172,12 @@ func newStringClass() *Class {
 						case StringInstance:
 							return NewStringInstance(self.Value + o.Value), nil
 						default:
-							return nil, nil
+							return NewNotImplementedInstance(), nil
 						}
 					},
 				),
 			},
+			makeStringMethods(),
 			MakeLogicalOperators(String),
 			MakeComparisonOperators(String, compareStrings),
 			MakeCommonOperators(String),
@@ -0,0 +1,366 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+func newStringMethod(
+	name string,
+	parameters []FunctionParameter,
+	handler func(common.State, StringInstance, []common.Value) (common.Value, error),
+	returnType *Class,
+	doc string,
+) *FunctionInstance {
+	return NewFunctionInstance(
+		name,
+		append(
+			[]FunctionParameter{
+				{
+					Type:       String,
+					Name:       "я",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			parameters...,
+		),
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return handler(state, (*args)[0].(StringInstance), (*args)[1:])
+		},
+		[]FunctionReturnType{
+			{
+				Type:       returnType,
+				IsNullable: false,
+			},
+		},
+		true,
+		nil,
+		doc,
+	)
+}
+
+func newStringList(values []string) *ListInstance {
+	list := NewListInstance()
+	for _, value := range values {
+		list.Values = append(list.Values, NewStringInstance(value))
+	}
+
+	return list
+}
+
+// runeIndex converts the byte index in the string to the index of
+// a character, -1 stays as is.
+func runeIndex(value string, byteIndex int) int64 {
+	if byteIndex < 0 {
+		return -1
+	}
+
+	return int64(utf8.RuneCountInString(value[:byteIndex]))
+}
+
+func getFillCharacter(value common.Value) (rune, error) {
+	fill := []rune(value.(StringInstance).Value)
+	if len(fill) != 1 {
+		return 0, util.RuntimeError("символ заповнення має бути рядком довжиною в один символ")
+	}
+
+	return fill[0], nil
+}
+
+// pad adds fill characters to the string until it has the given
+// width, 'left' and 'right' are shares of the added characters.
+func pad(value string, width int64, fill rune, left, right bool) string {
+	count := width - int64(utf8.RuneCountInString(value))
+	if count <= 0 {
+		return value
+	}
+
+	leftCount := int64(0)
+	switch {
+	case left && right:
+		leftCount = count / 2
+	case left:
+		leftCount = count
+	}
+
+	return strings.Repeat(string(fill), int(leftCount)) + value + strings.Repeat(string(fill), int(count-leftCount))
+}
+
+func newPadMethod(name string, left, right bool, doc string) *FunctionInstance {
+	return MustOverload(
+		newStringMethod(
+			name,
+			[]FunctionParameter{newIntegerParameter("ширина")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				return NewStringInstance(pad(self.Value, args[0].(IntegerInstance).Value, ' ', left, right)), nil
+			},
+			String,
+			doc+" пробілами.",
+		),
+		newStringMethod(
+			name,
+			[]FunctionParameter{newIntegerParameter("ширина"), newStringParameter("символ")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				fill, err := getFillCharacter(args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				return NewStringInstance(pad(self.Value, args[0].(IntegerInstance).Value, fill, left, right)), nil
+			},
+			String,
+			doc+" заданим символом.",
+		),
+	)
+}
+
+func newTrimMethod(
+	name string,
+	trimSpace func(string) string,
+	trim func(string, string) string,
+	where string,
+) *FunctionInstance {
+	return MustOverload(
+		newStringMethod(
+			name,
+			[]FunctionParameter{},
+			func(_ common.State, self StringInstance, _ []common.Value) (common.Value, error) {
+				return NewStringInstance(trimSpace(self.Value)), nil
+			},
+			String,
+			"Повертає рядок без пробільних символів "+where+".",
+		),
+		newStringMethod(
+			name,
+			[]FunctionParameter{newStringParameter("символи")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				return NewStringInstance(trim(self.Value, args[0].(StringInstance).Value)), nil
+			},
+			String,
+			"Повертає рядок без заданих символів "+where+".",
+		),
+	)
+}
+
+func newStringCheckMethod(name string, check func(rune) bool, doc string) *FunctionInstance {
+	return newStringMethod(
+		name,
+		[]FunctionParameter{},
+		func(_ common.State, self StringInstance, _ []common.Value) (common.Value, error) {
+			if len(self.Value) == 0 {
+				return NewBoolInstance(false), nil
+			}
+
+			for _, r := range self.Value {
+				if !check(r) {
+					return NewBoolInstance(false), nil
+				}
+			}
+
+			return NewBoolInstance(true), nil
+		},
+		Bool,
+		doc,
+	)
+}
+
+func newStringConversionMethod(name string, convert func(string) string, doc string) *FunctionInstance {
+	return newStringMethod(
+		name,
+		[]FunctionParameter{},
+		func(_ common.State, self StringInstance, _ []common.Value) (common.Value, error) {
+			return NewStringInstance(convert(self.Value)), nil
+		},
+		String,
+		doc,
+	)
+}
+
+func capitalize(value string) string {
+	first, size := utf8.DecodeRuneInString(value)
+	if size == 0 {
+		return value
+	}
+
+	return string(unicode.ToUpper(first)) + strings.ToLower(value[size:])
+}
+
+func makeStringMethods() map[string]common.Value {
+	return map[string]common.Value{
+		"розділити": MustOverload(
+			newStringMethod(
+				"розділити",
+				[]FunctionParameter{},
+				func(_ common.State, self StringInstance, _ []common.Value) (common.Value, error) {
+					return newStringList(strings.Fields(self.Value)), nil
+				},
+				List,
+				"Розділяє рядок на слова, відокремлені пробільними символами.",
+			),
+			newStringMethod(
+				"розділити",
+				[]FunctionParameter{newStringParameter("роздільник")},
+				func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+					separator := args[0].(StringInstance).Value
+					if len(separator) == 0 {
+						return nil, util.RuntimeError("роздільник не може бути порожнім рядком")
+					}
+
+					return newStringList(strings.Split(self.Value, separator)), nil
+				},
+				List,
+				"Розділяє рядок на частини між входженнями роздільника.",
+			),
+		),
+		"зʼєднати": newStringMethod(
+			"зʼєднати",
+			[]FunctionParameter{
+				{
+					Type:       Any,
+					Name:       "елементи",
+					IsVariadic: false,
+					IsNullable: false,
+				},
+			},
+			func(state common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				var parts []string
+				err := Iterate(
+					state, args[0], func(element common.Value) error {
+						part, ok := element.(StringInstance)
+						if !ok {
+							return util.RuntimeError(
+								fmt.Sprintf(
+									"'зʼєднати' очікує елементи типу '%s', отримано '%s'",
+									common.StringTypeName, element.GetTypeName(),
+								),
+							)
+						}
+
+						parts = append(parts, part.Value)
+						return nil
+					},
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				return NewStringInstance(strings.Join(parts, self.Value)), nil
+			},
+			String,
+			"Зʼєднує рядки з ітерованого об'єкта, розділяючи їх цим рядком. Апостроф у назві методу — "+
+				"літера 'ʼ' (U+02BC), бо звичайний апостроф (') не може бути частиною ідентифікатора.",
+		),
+		"замінити": MustOverload(
+			newStringMethod(
+				"замінити",
+				[]FunctionParameter{newStringParameter("старе"), newStringParameter("нове")},
+				func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+					return NewStringInstance(
+						strings.ReplaceAll(self.Value, args[0].(StringInstance).Value, args[1].(StringInstance).Value),
+					), nil
+				},
+				String,
+				"Замінює всі входження старого підрядка новим.",
+			),
+			newStringMethod(
+				"замінити",
+				[]FunctionParameter{
+					newStringParameter("старе"),
+					newStringParameter("нове"),
+					newIntegerParameter("кількість"),
+				},
+				func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+					return NewStringInstance(
+						strings.Replace(
+							self.Value,
+							args[0].(StringInstance).Value,
+							args[1].(StringInstance).Value,
+							int(args[2].(IntegerInstance).Value),
+						),
+					), nil
+				},
+				String,
+				"Замінює задану кількість перших входжень старого підрядка новим.",
+			),
+		),
+		"обрізати":        newTrimMethod("обрізати", strings.TrimSpace, strings.Trim, "з обох кінців"),
+		"обрізати_зліва":  newTrimMethod("обрізати_зліва", trimLeftSpace, strings.TrimLeft, "на початку"),
+		"обрізати_справа": newTrimMethod("обрізати_справа", trimRightSpace, strings.TrimRight, "в кінці"),
+		"починається_з": newStringMethod(
+			"починається_з",
+			[]FunctionParameter{newStringParameter("префікс")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				return NewBoolInstance(strings.HasPrefix(self.Value, args[0].(StringInstance).Value)), nil
+			},
+			Bool,
+			"Перевіряє, чи рядок починається з префікса.",
+		),
+		"закінчується_на": newStringMethod(
+			"закінчується_на",
+			[]FunctionParameter{newStringParameter("суфікс")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				return NewBoolInstance(strings.HasSuffix(self.Value, args[0].(StringInstance).Value)), nil
+			},
+			Bool,
+			"Перевіряє, чи рядок закінчується суфіксом.",
+		),
+		"знайти": newStringMethod(
+			"знайти",
+			[]FunctionParameter{newStringParameter("підрядок")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				return NewIntegerInstance(
+					runeIndex(self.Value, strings.Index(self.Value, args[0].(StringInstance).Value)),
+				), nil
+			},
+			Integer,
+			"Повертає індекс символу, з якого починається перше входження підрядка, або -1.",
+		),
+		"порахувати": newStringMethod(
+			"порахувати",
+			[]FunctionParameter{newStringParameter("підрядок")},
+			func(_ common.State, self StringInstance, args []common.Value) (common.Value, error) {
+				return NewIntegerInstance(int64(strings.Count(self.Value, args[0].(StringInstance).Value))), nil
+			},
+			Integer,
+			"Повертає кількість входжень підрядка, що не перекриваються.",
+		),
+		"верхній_регістр": newStringConversionMethod(
+			"верхній_регістр", strings.ToUpper, "Повертає рядок, записаний великими літерами.",
+		),
+		"нижній_регістр": newStringConversionMethod(
+			"нижній_регістр", strings.ToLower, "Повертає рядок, записаний малими літерами.",
+		),
+		"з_великої": newStringConversionMethod(
+			"з_великої", capitalize, "Повертає рядок з першою великою літерою, решта літер стають малими.",
+		),
+		"є_цифрами": newStringCheckMethod(
+			"є_цифрами", unicode.IsDigit, "Перевіряє, чи рядок непорожній і складається лише з цифр.",
+		),
+		"є_літерами": newStringCheckMethod(
+			"є_літерами", unicode.IsLetter, "Перевіряє, чи рядок непорожній і складається лише з літер.",
+		),
+		"доповнити_зліва": newPadMethod(
+			"доповнити_зліва", true, false, "Доповнює рядок до заданої ширини зліва",
+		),
+		"доповнити_справа": newPadMethod(
+			"доповнити_справа", false, true, "Доповнює рядок до заданої ширини справа",
+		),
+		"центрувати": newPadMethod(
+			"центрувати", true, true, "Розміщує рядок посередині заданої ширини, доповнюючи його з обох боків",
+		),
+	}
+}
+
+func trimLeftSpace(value string) string {
+	return strings.TrimLeftFunc(value, unicode.IsSpace)
+}
+
+func trimRightSpace(value string) string {
+	return strings.TrimRightFunc(value, unicode.IsSpace)
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// SuperInstance gives access to attributes of bases of the class the
+// method is defined in, the attributes are looked up in the method
+// resolution order of the object's class after that class. Methods
+// are bound to the object.
+type SuperInstance struct {
+	BuiltinInstance
+	class  *Class
+	object common.Value
+}
+
+func NewSuperInstance(class *Class, object common.Value) *SuperInstance {
+	super := &SuperInstance{
+		BuiltinInstance: BuiltinInstance{
+			ClassInstance{
+				class:      Super,
+				attributes: map[string]common.Value{},
+				address:    "",
+			},
+		},
+		class:  class,
+		object: object,
+	}
+
+	super.address = fmt.Sprintf("%p", super)
+	return super
+}
+
+func (s *SuperInstance) String(common.State) (string, error) {
+	return fmt.Sprintf("<батько класу '%s' з адресою %s>", s.class.GetName(), s.address), nil
+}
+
+func (s *SuperInstance) Representation(state common.State) (string, error) {
+	return s.String(state)
+}
+
+func (s *SuperInstance) AsBool(common.State) (bool, error) {
+	return true, nil
+}
+
+func (s *SuperInstance) GetAttribute(state common.State, name string) (common.Value, error) {
+	objectClass, ok := s.object.(*Class)
+	if !ok {
+		objectClass = s.object.(ObjectInstance).GetClass()
+	}
+
+	mro := objectClass.MRO()
+	for idx, class := range mro {
+		if class != s.class {
+			continue
+		}
+
+		attribute, ok := class.lookupAttribute(mro[idx+1:], name)
+		if !ok {
+			break
+		}
+
+		switch value := attribute.(type) {
+		case *FunctionInstance:
+			return s.bind(value), nil
+		case *PropertyInstance:
+			return value.Get(state, s.object)
+		}
+
+		return attribute, nil
+	}
+
+	return nil, util.AttributeNotFoundError(s.GetTypeName(), name)
+}
+
+func (s *SuperInstance) SetAttribute(_ common.State, name string, _ common.Value) error {
+	return util.AttributeIsReadOnlyError(s.GetTypeName(), name)
+}
+
+// bind returns a function which calls the method with the object,
+// or with its class for class methods, as the first argument.
+func (s *SuperInstance) bind(method *FunctionInstance) *FunctionInstance {
+	if method.IsStatic {
+		return method
+	}
+
+	self := s.object
+	if method.IsClassMethod {
+		if _, ok := self.(*Class); !ok {
+			self = self.(ObjectInstance).GetClass()
+		}
+	}
+
+	bound := NewFunctionInstance(
+		method.Name,
+		[]FunctionParameter{
+			{
+				Type:       Any,
+				Name:       "аргументи",
+				IsVariadic: true,
+				IsNullable: true,
+			},
+		},
+		func(state common.State, args *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			methodArgs := append([]common.Value{self}, *args...)
+			return Call(state, method, &methodArgs, nil)
+		},
+		[]FunctionReturnType{
+			{
+				Type:       Any,
+				IsNullable: true,
+			},
+		},
+		true,
+		nil,
+		"",
+	)
+
+	bound.IsStatic = true
+	return bound
+}
+
+func newSuperClass() *Class {
+	initAttributes := func(attrs *map[string]common.Value) {
+		*attrs = MergeAttributes(
+			MakeLogicalOperators(Super),
+			MakeCommonOperators(Super),
+		)
+	}
+
+	return &Class{
+		Name:            common.SuperTypeName,
+		IsFinal:         true,
+		Bases:           []*Class{},
+		Parent:          BuiltinPackage,
+		AttrInitializer: initAttributes,
+		GetEmptyInstance: func() (common.Value, error) {
+			panic("unreachable")
+		},
+	}
+}
@@ -10,17 +10,23 @@ type ObjectInstance interface {
 }
 
 var (
-	Any        *Class = nil
-	TypeClass  *Class = nil
-	Nil        *Class = nil
-	Bool       *Class = nil
-	Dictionary *Class = nil
-	Function   *Class = nil
-	Integer    *Class = nil
-	List       *Class = nil
-	Package    *Class = nil
-	Real       *Class = nil
-	String     *Class = nil
+	Any            *Class = nil
+	TypeClass      *Class = nil
+	Nil            *Class = nil
+	NotImplemented *Class = nil
+	Bool           *Class = nil
+	Dictionary     *Class = nil
+	Function       *Class = nil
+	Generator      *Class = nil
+	Integer        *Class = nil
+	Iterator       *Class = nil
+	List           *Class = nil
+	Package        *Class = nil
+	Property       *Class = nil
+	Range          *Class = nil
+	Real           *Class = nil
+	String         *Class = nil
+	Super          *Class = nil
 )
 
 var BuiltinPackage *PackageInstance
@@ -28,27 +34,42 @@ var BuiltinPackage *PackageInstance
 func Init() {
 	BuiltinPackage = NewPackageInstance(nil, "вбудований", nil, map[string]common.Value{})
 
+	// other classes refer to 'довільний' in their attributes
+	Any = newAnyClass()
 	TypeClass = newTypeClass()
 	Nil = newNilClass()
+	NotImplemented = newNotImplementedClass()
 	Bool = newBoolClass()
 	Dictionary = newDictionaryClass()
 	Function = newFunctionClass()
+	Generator = newGeneratorClass()
 	Integer = newIntegerClass()
+	Iterator = newIteratorClass()
 	List = newListClass()
 	Package = NewPackageClass()
+	Property = newPropertyClass()
+	Range = newRangeClass()
 	Real = newRealClass()
 	String = newStringClass()
+	Super = newSuperClass()
 
+	initClass(Any)
 	initClass(TypeClass)
 	initClass(Nil)
+	initClass(NotImplemented)
 	initClass(Bool)
 	initClass(Dictionary)
 	initClass(Function)
+	initClass(Generator)
 	initClass(Integer)
+	initClass(Iterator)
 	initClass(List)
 	initClass(Package)
+	initClass(Property)
+	initClass(Range)
 	initClass(Real)
 	initClass(String)
+	initClass(Super)
 }
 
 func initClass(cls *Class) {
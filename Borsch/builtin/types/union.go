@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// NewUnionType creates a type which values are of one of the member
+// types. Unnamed unions are named after their members.
+func NewUnionType(name string, members []TypeArgument, parent common.Value) *Class {
+	if len(name) == 0 {
+		var names []string
+		for _, member := range members {
+			names = append(names, member.String())
+		}
+
+		name = strings.Join(names, " | ")
+	}
+
+	union := &Class{
+		Name:         name,
+		attributes:   map[string]common.Value{},
+		Bases:        []*Class{},
+		Parent:       parent,
+		unionMembers: members,
+	}
+
+	union.GetEmptyInstance = func() (common.Value, error) {
+		return nil, util.RuntimeError(fmt.Sprintf("неможливо створити об'єкт типу-об'єднання '%s'", name))
+	}
+
+	union.Setup()
+	return union
+}
+
+func (c *Class) IsUnion() bool {
+	return c.unionMembers != nil
+}
+
+// matchesUnion checks if the value is of one of the union types.
+func (b *TypeBindings) matchesUnion(value common.Value, union *Class, isNullable bool) bool {
+	for _, member := range union.unionMembers {
+		if b.Matches(value, member.Type, isNullable || member.IsNullable, member.TypeArguments) {
+			return true
+		}
+	}
+
+	return false
+}
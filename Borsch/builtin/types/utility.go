@@ -3,8 +3,11 @@ package types
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
 func getIndex(index, length int64) (int64, error) {
@@ -41,12 +44,12 @@ func boolToFloat64(v bool) float64 {
 	return 0.0
 }
 
-func getAttributes(attributes map[string]common.Value) (DictionaryInstance, error) {
+func getAttributes(state common.State, attributes map[string]common.Value) (*DictionaryInstance, error) {
 	dict := NewDictionaryInstance()
 	for key, val := range attributes {
-		err := dict.SetElement(NewStringInstance(key), val)
+		err := dict.SetElement(state, NewStringInstance(key), val)
 		if err != nil {
-			return DictionaryInstance{}, err
+			return nil, err
 		}
 	}
 
@@ -57,6 +60,8 @@ func getLength(state common.State, sequence common.Value) (int64, error) {
 	switch self := sequence.(type) {
 	case common.SequentialType:
 		return self.Length(state), nil
+	case *DictionaryInstance:
+		return self.Length(state), nil
 	}
 
 	return 0, errors.New(fmt.Sprint("invalid type in length operator: ", sequence.GetTypeName()))
@@ -71,3 +76,157 @@ func MergeAttributes(a map[string]common.Value, b ...map[string]common.Value) ma
 
 	return a
 }
+
+// Equals compares values using common.EqualsOp, see CallBinaryOperator.
+func Equals(state common.State, self common.Value, other common.Value) (bool, error) {
+	result, err := CallBinaryOperator(state, common.EqualsOp, self, other)
+	if err != nil {
+		return false, err
+	}
+
+	return result.AsBool(state)
+}
+
+// sameObject checks if both values refer to the same object.
+func sameObject(a, b common.Value) bool {
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	return aValue.Kind() == reflect.Ptr && bValue.Kind() == reflect.Ptr && aValue.Pointer() == bValue.Pointer()
+}
+
+// containerComparison compares lists and dictionaries by their elements.
+// It holds pairs of containers which are being compared, so the
+// comparison of containers which refer to themselves terminates.
+type containerComparison struct {
+	state    common.State
+	visiting map[[2]uintptr]bool
+}
+
+func newContainerComparison(state common.State) *containerComparison {
+	return &containerComparison{state: state, visiting: map[[2]uintptr]bool{}}
+}
+
+// compare compares containers of the same type unless they are the same
+// object or are already being compared, they are considered equal then.
+func (c *containerComparison) compare(op common.Operator, self, other common.Value) (int, error) {
+	if sameObject(self, other) {
+		return 0, nil
+	}
+
+	pair := [2]uintptr{reflect.ValueOf(self).Pointer(), reflect.ValueOf(other).Pointer()}
+	if c.visiting[pair] {
+		return 0, nil
+	}
+
+	c.visiting[pair] = true
+	defer delete(c.visiting, pair)
+	switch left := self.(type) {
+	case *ListInstance:
+		return c.compareSequences(op, left.Values, other.(*ListInstance).Values)
+	case *DictionaryInstance:
+		return c.compareEntries(left, other.(*DictionaryInstance))
+	}
+
+	return 0, util.IncorrectUseOfFunctionError("containerComparison.compare")
+}
+
+// call compares nested containers of the same type within the current
+// comparison, other values are compared by their operators.
+func (c *containerComparison) call(op common.Operator, left, right common.Value) (bool, error) {
+	if areComparableContainers(op, left, right) {
+		result, err := c.compare(op, left, right)
+		if err != nil {
+			return false, err
+		}
+
+		switch op {
+		case common.EqualsOp:
+			return result == 0, nil
+		case common.LessOp:
+			return result == -1, nil
+		}
+
+		return false, util.IncorrectUseOfFunctionError("containerComparison.call")
+	}
+
+	result, err := CallBinaryOperator(c.state, op, left, right)
+	if err != nil {
+		return false, err
+	}
+
+	return result.AsBool(c.state)
+}
+
+func areComparableContainers(op common.Operator, left, right common.Value) bool {
+	switch left.(type) {
+	case *ListInstance:
+		_, ok := right.(*ListInstance)
+		return ok
+	case *DictionaryInstance:
+		_, ok := right.(*DictionaryInstance)
+		return ok && op == common.EqualsOp
+	}
+
+	return false
+}
+
+// containerRepresentation represents lists and dictionaries by their
+// elements. It holds containers which are being represented, so a
+// container which refers to itself is represented as "[...]" or "{...}".
+type containerRepresentation struct {
+	state    common.State
+	visiting map[uintptr]bool
+}
+
+func newContainerRepresentation(state common.State) *containerRepresentation {
+	return &containerRepresentation{state: state, visiting: map[uintptr]bool{}}
+}
+
+// represent represents nested containers within the current
+// representation, other values are represented by themselves.
+func (c *containerRepresentation) represent(value common.Value) (string, error) {
+	var opening, closing string
+	switch value.(type) {
+	case *ListInstance:
+		opening, closing = "[", "]"
+	case *DictionaryInstance:
+		opening, closing = "{", "}"
+	default:
+		return value.Representation(c.state)
+	}
+
+	address := reflect.ValueOf(value).Pointer()
+	if c.visiting[address] {
+		return opening + "..." + closing, nil
+	}
+
+	c.visiting[address] = true
+	defer delete(c.visiting, address)
+	var items []string
+	switch container := value.(type) {
+	case *ListInstance:
+		for _, element := range container.Values {
+			item, err := c.represent(element)
+			if err != nil {
+				return "", err
+			}
+
+			items = append(items, item)
+		}
+	case *DictionaryInstance:
+		for _, entry := range container.Entries() {
+			key, err := c.represent(entry.Key)
+			if err != nil {
+				return "", err
+			}
+
+			element, err := c.represent(entry.Value)
+			if err != nil {
+				return "", err
+			}
+
+			items = append(items, fmt.Sprintf("%s: %s", key, element))
+		}
+	}
+
+	return opening + strings.Join(items, ", ") + closing, nil
+}
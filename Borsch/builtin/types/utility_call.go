@@ -18,7 +18,13 @@ func Call(
 		args = &[]common.Value{}
 	}
 
-	if err := CheckFunctionArguments(function, args, nil); err != nil {
+	function, err := function.resolveOverload(*args)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := CheckFunctionArguments(function, args, nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -27,6 +33,18 @@ func Call(
 	}
 
 	updateKwargs(*args, kwargs, function.Parameters)
+	for parameter, class := range bindings.types {
+		(*kwargs)[parameter.GetName()] = class
+	}
+
+	if function.DefiningClass != nil && !function.IsStatic && len(*args) != 0 {
+		(*kwargs)[common.SuperAttributeName] = NewSuperInstance(function.DefiningClass, (*args)[0])
+	}
+
+	for _, parameter := range function.TypeParameters {
+		(*kwargs)[parameter.GetName()] = bindings.Get(parameter)
+	}
+
 	ctx := function.GetContext()
 	if ctx == nil {
 		ctx = state.GetContext()
@@ -40,7 +58,7 @@ func Call(
 		return nil, err
 	}
 
-	if err := CheckResult(funcState, result, function); err != nil {
+	if err := CheckResult(funcState, result, function, bindings); err != nil {
 		return nil, err
 	}
 
@@ -58,7 +76,15 @@ func CallAttribute(
 ) (common.Value, error) {
 	switch function := attribute.(type) {
 	case *FunctionInstance:
-		if isMethod {
+		if isMethod && !function.IsStatic {
+			if function.IsClassMethod {
+				if class, ok := object.(*Class); ok {
+					object = class
+				} else {
+					object = object.(ObjectInstance).GetClass()
+				}
+			}
+
 			if len(function.Parameters) == 0 {
 				functionStr, err := function.Representation(state)
 				if err != nil {
@@ -89,7 +115,7 @@ func CallByName(
 	kwargs *map[string]common.Value,
 	isMethod bool,
 ) (common.Value, error) {
-	attribute, err := object.GetAttribute(funcName)
+	attribute, err := object.GetAttribute(state, funcName)
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +123,37 @@ func CallByName(
 	return CallAttribute(state, object, attribute, funcName, args, kwargs, isMethod)
 }
 
+// CallObject calls any callable object: a function, a class, which
+// creates a new instance, or an object with common.CallOperatorName.
+func CallObject(state common.State, object common.Value, args *[]common.Value) (common.Value, error) {
+	if args == nil {
+		args = &[]common.Value{}
+	}
+
+	switch callable := object.(type) {
+	case *FunctionInstance:
+		return Call(state, callable, args, nil)
+	case *Class:
+		instance, err := callable.GetEmptyInstance()
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = CallByName(state, instance, common.ConstructorName, args, nil, true)
+		if err != nil {
+			return nil, err
+		}
+
+		return (*args)[0], nil
+	}
+
+	if object.HasAttribute(common.CallOperatorName) {
+		return CallByName(state, object, common.CallOperatorName, args, nil, true)
+	}
+
+	return nil, util.ObjectIsNotCallable("", object.GetTypeName())
+}
+
 func updateKwargs(args []common.Value, kwargs *map[string]common.Value, funcArgs []FunctionParameter) {
 	argsLen := len(args)
 	var i int
@@ -110,3 +167,88 @@ func updateKwargs(args []common.Value, kwargs *map[string]common.Value, funcArgs
 		(*kwargs)[funcArgs[i].Name] = list
 	}
 }
+
+// CallBinaryOperator calls the operator of the left operand. If the
+// left operand does not support the right one, the reflected operator
+// of the right operand is called, the mirrored one for comparisons.
+// The reflected operator is tried first if the right operand is of a
+// subclass of the left operand's class. If neither operand supports
+// inequality, the result of equality is negated; if neither supports
+// equality, they are equal only if they are the same object.
+func CallBinaryOperator(state common.State, op common.Operator, left, right common.Value) (common.Value, error) {
+	reflectedName := op.ReflectedName()
+	if mirrored, ok := op.Mirrored(); ok {
+		reflectedName = mirrored.Name()
+	} else if !op.IsReflectable() {
+		operator, err := left.GetOperator(op.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		return CallAttribute(state, left, operator, op.Name(), &[]common.Value{right}, nil, true)
+	}
+
+	leftClass := left.(ObjectInstance).GetClass()
+	rightClass := right.(ObjectInstance).GetClass()
+	if rightClass != leftClass && rightClass.HasBase(leftClass) {
+		result, err := callOperatorIfExists(state, right, reflectedName, left)
+		if err != nil || !IsNotImplemented(result) {
+			return result, err
+		}
+
+		reflectedName = ""
+	}
+
+	result, err := callOperatorIfExists(state, left, op.Name(), right)
+	if err != nil || !IsNotImplemented(result) {
+		return result, err
+	}
+
+	if len(reflectedName) != 0 {
+		result, err = callOperatorIfExists(state, right, reflectedName, left)
+		if err != nil || !IsNotImplemented(result) {
+			return result, err
+		}
+	}
+
+	switch op {
+	case common.EqualsOp:
+		// objects which do not define equality are equal only to themselves
+		return NewBoolInstance(sameObject(left, right)), nil
+	case common.NotEqualsOp:
+		equals, err := Equals(state, left, right)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewBoolInstance(!equals), nil
+	}
+
+	return nil, util.OperandsNotSupportedError(op, left.GetTypeName(), right.GetTypeName())
+}
+
+// CallInPlaceOperator calls the in-place operator of the left operand
+// which is expected to modify it. If the operand does not support it,
+// the result of the binary operator is returned.
+func CallInPlaceOperator(state common.State, op common.Operator, left, right common.Value) (common.Value, error) {
+	result, err := callOperatorIfExists(state, left, op.InPlaceName(), right)
+	if err != nil || !IsNotImplemented(result) {
+		return result, err
+	}
+
+	return CallBinaryOperator(state, op, left, right)
+}
+
+// callOperatorIfExists returns 'нереалізовано' if the object does
+// not have the operator.
+func callOperatorIfExists(state common.State, object common.Value, name string, other common.Value) (
+	common.Value,
+	error,
+) {
+	operator, err := object.GetOperator(name)
+	if err != nil {
+		return NewNotImplementedInstance(), nil
+	}
+
+	return CallAttribute(state, object, operator, name, &[]common.Value{other}, nil, true)
+}
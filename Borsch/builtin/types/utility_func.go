@@ -9,7 +9,7 @@ import (
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
-func checkArgument(parameter *FunctionParameter, arg common.Value, isVariadic bool) error {
+func checkArgument(parameter *FunctionParameter, arg common.Value, isVariadic bool, bindings *TypeBindings) error {
 	if parameter == nil {
 		return errors.New("checkArgument: parameter is nil")
 	}
@@ -18,16 +18,7 @@ func checkArgument(parameter *FunctionParameter, arg common.Value, isVariadic bo
 		return errors.New("checkArgument: arg is nil")
 	}
 
-	if parameter.Type == Any {
-		return nil
-	}
-
-	argPrototype := arg.(ObjectInstance).GetClass()
-	if argPrototype == Nil && parameter.IsNullable {
-		return nil
-	}
-
-	if parameter.Type == argPrototype || argPrototype.HasBase(parameter.Type) {
+	if bindings.Matches(arg, parameter.Type, parameter.IsNullable, parameter.TypeArguments) {
 		return nil
 	}
 
@@ -48,8 +39,24 @@ func checkArgument(parameter *FunctionParameter, arg common.Value, isVariadic bo
 	)
 }
 
-func CheckFunctionArguments(function *FunctionInstance, args *[]common.Value, _ *map[string]common.Value) error {
-	parametersLen := len(*args)
+// CheckFunctionArguments checks types of arguments, returns types which
+// type parameters are bound to during the call.
+func CheckFunctionArguments(
+	function *FunctionInstance,
+	args *[]common.Value,
+	_ *map[string]common.Value,
+) (*TypeBindings, error) {
+	bindings := NewTypeBindings()
+	if err := checkArguments(function, *args, bindings); err != nil {
+		return nil, err
+	}
+
+	bindings.inferInstanceArguments()
+	return bindings, nil
+}
+
+func checkArguments(function *FunctionInstance, args []common.Value, bindings *TypeBindings) error {
+	parametersLen := len(args)
 	argsLen := len(function.Parameters)
 	if argsLen > 0 && function.Parameters[argsLen-1].IsVariadic {
 		argsLen--
@@ -64,17 +71,17 @@ func CheckFunctionArguments(function *FunctionInstance, args *[]common.Value, _
 
 	var c int
 	for c = 0; c < argsLen; c++ {
-		if err := checkArgument(&function.Parameters[c], (*args)[c], false); err != nil {
+		if err := checkArgument(&function.Parameters[c], args[c], false, bindings); err != nil {
 			return err
 		}
 	}
 
 	if len(function.Parameters) > 0 {
 		if lastArgument := function.Parameters[len(function.Parameters)-1]; lastArgument.IsVariadic {
-			if len(*args)-parametersLen > 0 {
-				parametersLen = len(*args)
+			if len(args)-parametersLen > 0 {
+				parametersLen = len(args)
 				for k := c; k < parametersLen; k++ {
-					if err := checkArgument(&lastArgument, (*args)[k], true); err != nil {
+					if err := checkArgument(&lastArgument, args[k], true, bindings); err != nil {
 						return err
 					}
 				}
@@ -85,9 +92,14 @@ func CheckFunctionArguments(function *FunctionInstance, args *[]common.Value, _
 	return nil
 }
 
-func CheckResult(state common.State, result common.Value, function *FunctionInstance) error {
+func CheckResult(state common.State, result common.Value, function *FunctionInstance, bindings *TypeBindings) error {
+	// operators tell this way that they do not support the operand
+	if IsNotImplemented(result) && common.IsOperator(function.Name) {
+		return nil
+	}
+
 	if len(function.ReturnTypes) == 1 {
-		err := checkSingleResult(state, result, function.ReturnTypes[0], function.Name)
+		err := checkSingleResult(state, result, function.ReturnTypes[0], function.Name, bindings)
 		if err != nil {
 			return errors.New(fmt.Sprintf(err.Error(), ""))
 		}
@@ -96,7 +108,7 @@ func CheckResult(state common.State, result common.Value, function *FunctionInst
 	}
 
 	switch value := result.(type) {
-	case ListInstance:
+	case *ListInstance:
 		if int64(len(function.ReturnTypes)) != value.Length(state) {
 			var expectedTypes []string
 			for _, retType := range function.ReturnTypes {
@@ -120,7 +132,7 @@ func CheckResult(state common.State, result common.Value, function *FunctionInst
 
 		// TODO: check values in list
 		for i, returnType := range function.ReturnTypes {
-			if err := checkSingleResult(state, value.Values[i], returnType, function.Name); err != nil {
+			if err := checkSingleResult(state, value.Values[i], returnType, function.Name, bindings); err != nil {
 				return errors.New(fmt.Sprintf(err.Error(), fmt.Sprintf(" на позиції %d", i+1)))
 			}
 		}
@@ -148,31 +160,32 @@ func checkSingleResult(
 	result common.Value,
 	returnType FunctionReturnType,
 	funcName string,
+	bindings *TypeBindings,
 ) error {
-	if result.(ObjectInstance).GetClass() == Nil {
-		if returnType.Type != Nil && !returnType.IsNullable {
-			resultStr, err := result.String(state)
-			if err != nil {
-				return err
-			}
+	if bindings.Matches(result, returnType.Type, returnType.IsNullable, returnType.TypeArguments) {
+		return nil
+	}
 
-			return util.RuntimeError(
-				fmt.Sprintf(
-					"%s() повертає ненульове значення%s, отримано '%s'",
-					funcName, "%s", resultStr,
-				),
-			)
+	if result.(ObjectInstance).GetClass() == Nil {
+		resultStr, err := result.String(state)
+		if err != nil {
+			return err
 		}
-	} else if returnType.Type != Any && result.(ObjectInstance).GetClass() != returnType.Type {
+
 		return util.RuntimeError(
 			fmt.Sprintf(
-				"'%s()' повертає значення типу '%s'%s, отримано значення з типом '%s'",
-				funcName, returnType.String(), "%s", result.GetTypeName(),
+				"%s() повертає ненульове значення%s, отримано '%s'",
+				funcName, "%s", resultStr,
 			),
 		)
 	}
 
-	return nil
+	return util.RuntimeError(
+		fmt.Sprintf(
+			"'%s()' повертає значення типу '%s'%s, отримано значення з типом '%s'",
+			funcName, returnType.String(), "%s", result.GetTypeName(),
+		),
+	)
 }
 
 func makeArgumentError(argsLen, parametersLen int, params []FunctionParameter, funcName string) error {
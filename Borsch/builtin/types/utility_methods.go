@@ -74,6 +74,11 @@ func newUnaryMethod(
 	)
 }
 
+// notComparable is returned by comparators if they do not support
+// the type of the other value, the comparison operator returns
+// 'нереалізовано' then, so the reflected operator is tried.
+const notComparable = -3
+
 func NewComparisonOperator(
 	operator common.Operator,
 	itemType *Class,
@@ -92,6 +97,10 @@ func NewComparisonOperator(
 				return nil, err
 			}
 
+			if res == notComparable {
+				return NewNotImplementedInstance(), nil
+			}
+
 			return NewBoolInstance(checker(res)), nil
 		},
 	)
@@ -330,3 +339,37 @@ func getDefaultConstructor(cls *Class, doc string) *FunctionInstance {
 		doc,
 	)
 }
+
+// MustOverload combines implementations of a built-in function or
+// method which differ in parameters, e.g. with and without an optional
+// argument.
+func MustOverload(first *FunctionInstance, others ...*FunctionInstance) *FunctionInstance {
+	method := first
+	for _, other := range others {
+		var err error
+		method, err = method.Overload(other)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return method
+}
+
+func newStringParameter(name string) FunctionParameter {
+	return FunctionParameter{
+		Type:       String,
+		Name:       name,
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
+
+func newIntegerParameter(name string) FunctionParameter {
+	return FunctionParameter{
+		Type:       Integer,
+		Name:       name,
+		IsVariadic: false,
+		IsNullable: false,
+	}
+}
@@ -8,16 +8,6 @@ import (
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
-func deepCopy(object common.Value) (common.Value, error) {
-	switch value := object.(type) {
-	case *types.ClassInstance:
-		copied := value.Copy()
-		return copied, nil
-	default:
-		return value, nil
-	}
-}
-
 func runUnaryOperator(state common.State, name string, object common.Value, expectedType *types.Class) (
 	common.Value,
 	error,
@@ -11,18 +11,24 @@ const (
 
 // Built-in types
 const (
-	AnyTypeName        = "довільний"
-	BoolTypeName       = "логічний"
-	DictionaryTypeName = "словник"
-	FunctionTypeName   = "функція"
-	IntegerTypeName    = "цілий"
-	ListTypeName       = "список"
-	NilTypeName        = "нульовий"
-	PackageTypeName    = "пакет"
-	RealTypeName       = "дійсний"
-	StringTypeName     = "рядок"
-	TypeTypeName       = "тип"
-	ErrorTypeName      = "Помилка"
+	AnyTypeName            = "довільний"
+	BoolTypeName           = "логічний"
+	DictionaryTypeName     = "словник"
+	FunctionTypeName       = "функція"
+	GeneratorTypeName      = "генератор"
+	IntegerTypeName        = "цілий"
+	IteratorTypeName       = "ітератор"
+	ListTypeName           = "список"
+	NilTypeName            = "нульовий"
+	NotImplementedTypeName = "нереалізований"
+	PackageTypeName        = "пакет"
+	PropertyTypeName       = "властивість"
+	RangeTypeName          = "діапазон"
+	RealTypeName           = "дійсний"
+	StringTypeName         = "рядок"
+	SuperTypeName          = "батько"
+	TypeTypeName           = "тип"
+	ErrorTypeName          = "Помилка"
 )
 
 // Special attributes
@@ -31,6 +37,10 @@ const (
 	AttributesName        = "__атрибути__"
 	DocAttributeName      = "__документ__"
 	ExportedAttributeName = "__експортовані__"
+
+	// SuperAttributeName is a variable the method accesses bases
+	// of its class through.
+	SuperAttributeName = "__батько__"
 )
 
 // Special operators
@@ -41,4 +51,7 @@ const (
 	BoolOperatorName           = "__логічний__"
 	StringOperatorName         = "__рядок__"
 	RepresentationOperatorName = "__представлення__"
+	IteratorOperatorName       = "__ітератор__"
+	HashOperatorName           = "__хеш__"
+	CopyOperatorName           = "__копіювати__"
 )
@@ -18,18 +18,29 @@ type Context interface {
 	TopScope() map[string]Value
 	GetVar(name string) (Value, error)
 	SetVar(name string, value Value) error
+	SetConst(name string, value Value) error
+	DeclareVar(name string, variableType TypeHint, value Value) error
+	IsConstant(name string) bool
 	GetClass(name string) (Value, error)
 	GetChild() Context
 }
 
+// TypeHint is a type of explicitly typed variable.
+type TypeHint interface {
+	GetTypeName() string
+	Accepts(Value) bool
+}
+
 type State interface {
 	GetParser() Parser
 	GetInterpreter() Interpreter
 	GetContext() Context
 	GetCurrentPackage() Value
 	GetCurrentPackageOrNil() Value
+	GetGenerator() GeneratorType
 	WithContext(Context) State
 	WithPackage(p Value) State
+	WithGenerator(GeneratorType) State
 }
 
 type Evaluatable interface {
@@ -46,8 +57,8 @@ type Value interface {
 	AsBool(State) (bool, error)
 	GetTypeName() string
 	GetOperator(string) (Value, error)
-	GetAttribute(string) (Value, error)
-	SetAttribute(string, Value) error
+	GetAttribute(State, string) (Value, error)
+	SetAttribute(State, string, Value) error
 	HasAttribute(string) bool
 }
 
@@ -61,3 +72,16 @@ type SequentialType interface {
 type CallableType interface {
 	Call(State, *[]Value, *map[string]Value) (Value, error)
 }
+
+// IteratorType produces elements of some collection one by one.
+// The second result is false when there are no more elements.
+type IteratorType interface {
+	Next(State) (Value, bool, error)
+}
+
+// GeneratorType suspends evaluation of the generator function body
+// until the next value is requested. Returns the value sent back
+// to the generator.
+type GeneratorType interface {
+	Yield(State, Value) (Value, error)
+}
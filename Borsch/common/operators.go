@@ -1,6 +1,9 @@
 package common
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Operator int
 
@@ -115,11 +118,54 @@ func (op Operator) Name() string {
 	)
 }
 
+// ReflectedName returns a name of the operator which is called on the
+// right operand when the left one does not support the operation,
+// e.g. '__р_оператор_суми__' for '+'.
+func (op Operator) ReflectedName() string {
+	return "__р_" + strings.TrimPrefix(op.Name(), "__")
+}
+
+// InPlaceName returns a name of the operator which is called by the
+// augmented assignment, e.g. '__м_оператор_суми__' for '+='.
+func (op Operator) InPlaceName() string {
+	return "__м_" + strings.TrimPrefix(op.Name(), "__")
+}
+
+// IsReflectable checks if the operator has the reflected and
+// in-place variants.
+func (op Operator) IsReflectable() bool {
+	return op >= PowOp && op <= DivOp || op >= BitwiseLeftShiftOp && op <= BitwiseOrOp
+}
+
+// Mirrored returns the comparison operator which gives the same result
+// with swapped operands, e.g. '>' for '<'.
+func (op Operator) Mirrored() (Operator, bool) {
+	switch op {
+	case EqualsOp, NotEqualsOp:
+		return op, true
+	case GreaterOp:
+		return LessOp, true
+	case GreaterOrEqualsOp:
+		return LessOrEqualsOp, true
+	case LessOp:
+		return GreaterOp, true
+	case LessOrEqualsOp:
+		return GreaterOrEqualsOp, true
+	}
+
+	return op, false
+}
+
 func IsOperator(name string) bool {
-	for _, current := range opNames {
+	for idx, current := range opNames {
 		if current == name {
 			return true
 		}
+
+		op := Operator(idx)
+		if op.IsReflectable() && (op.ReflectedName() == name || op.InPlaceName() == name) {
+			return true
+		}
 	}
 
 	return false
@@ -24,18 +24,24 @@ type LoopStmt struct {
 }
 
 // RangeBasedLoop is a loop with two bounds to
-// iterate over.
+// iterate over. If the right bound is omitted,
+// the loop iterates over elements of the left
+// bound, which should be an iterable object.
 //
 //   цикл (і : 1 .. 7)
 //   {
 //   }
+//
+//   цикл (елемент : [1, 2, 3])
+//   {
+//   }
 type RangeBasedLoop struct {
 	Pos lexer.Position
 
 	Variable   string      `@Ident ":"`
 	LeftBound  *Expression `@@`
-	SS         string      `@("."".")`
-	RightBound *Expression `@@`
+	SS         string      `[ @("."".")`
+	RightBound *Expression `  @@ ]`
 }
 
 type ConditionalLoop struct {
@@ -58,6 +64,29 @@ type ElseIfStmt struct {
 	Body      *BlockStmts `"{" @@ "}"`
 }
 
+// SwitchStmt executes body of the first case which has a value
+// equal to the given one.
+//
+//   вибір (колір) {
+//       випадок Колір.ЧЕРВОНИЙ, Колір.ЖОВТИЙ { ... }
+//       випадок Колір.ЗЕЛЕНИЙ { ... }
+//       інакше { ... }
+//   }
+type SwitchStmt struct {
+	Pos lexer.Position
+
+	Value *Expression   `"вибір" "(" @@ ")" "{"`
+	Cases []*SwitchCase `@@*`
+	Else  *BlockStmts   `("інакше" "{" @@ "}")? "}"`
+}
+
+type SwitchCase struct {
+	Pos lexer.Position
+
+	Values []*Expression `"випадок" @@ ("," @@)*`
+	Body   *BlockStmts   `"{" @@ "}"`
+}
+
 type BlockStmts struct {
 	Pos lexer.Position
 
@@ -67,15 +96,60 @@ type BlockStmts struct {
 type Stmt struct {
 	Pos lexer.Position
 
-	IfStmt      *IfStmt      `  @@`
-	LoopStmt    *LoopStmt    `| @@`
-	Block       *BlockStmts  `| "{" @@ "}"`
-	FunctionDef *FunctionDef `| @@`
-	ClassDef    *ClassDef    `| @@`
-	ReturnStmt  *ReturnStmt  `| @@`
-	BreakStmt   bool         `| @"перервати"`
-	Assignment  *Assignment  `| (@@ ";")`
-	Empty       bool         `| @";"`
+	IfStmt       *IfStmt       `  @@`
+	SwitchStmt   *SwitchStmt   `| @@`
+	LoopStmt     *LoopStmt     `| @@`
+	Block        *BlockStmts   `| "{" @@ "}"`
+	Decorated    *DecoratedDef `| @@`
+	FunctionDef  *FunctionDef  `| @@`
+	ClassDef     *ClassDef     `| @@`
+	InterfaceDef *InterfaceDef `| @@`
+	EnumDef      *EnumDef      `| @@`
+	RecordDef    *RecordDef    `| @@`
+	ReturnStmt   *ReturnStmt   `| @@`
+	BreakStmt    bool          `| @"перервати"`
+	ConstDef     *ConstDef     `| @@`
+	VarDef       *VarDef       `| @@`
+	TypeAliasDef *TypeAliasDef `| @@`
+	Assignment   *Assignment   `| (@@ ";")`
+	Empty        bool          `| @";"`
+}
+
+// ConstDef declares a name which can not be rebound. Constants in
+// a class body become read-only attributes of the class.
+//
+//   стала пі = 3.14159;
+type ConstDef struct {
+	Pos lexer.Position
+
+	Name  string      `"стала" @Ident "="`
+	Value *Expression `@@ ";"`
+}
+
+// VarDef declares a variable of an explicit type, the type is checked
+// on every assignment. The value may be omitted for nullable types.
+//
+//   змінна ім'я: рядок = "Борщ";
+//   змінна результат: цілий?;
+type VarDef struct {
+	Pos lexer.Position
+
+	Name       string      `"змінна" @Ident ":"`
+	Type       *Annotation `@@`
+	IsNullable bool        `@"?"?`
+	Value      *Expression `("=" @@)? ";"`
+}
+
+// TypeAliasDef gives a name to a type annotation.
+//
+//   тип Число = цілий | дійсний;
+//   тип Рядки = список[рядок]?;
+type TypeAliasDef struct {
+	Pos lexer.Position
+
+	Name       string      `"тип" @Ident "="`
+	Type       *Annotation `@@`
+	IsNullable bool        `@"?"? ";"`
 }
 
 type FunctionBody struct {
@@ -84,13 +158,48 @@ type FunctionBody struct {
 	Stmts *BlockStmts `@@`
 }
 
+// DecoratedDef is a function or class definition which is
+// passed through decorators before binding to its name.
+//
+//   @декоратор
+//   @декоратор_з_аргументами(1, 2)
+//   функція ф() {
+//   }
+type DecoratedDef struct {
+	Pos lexer.Position
+
+	Decorators  []*Decorator `@@+`
+	FunctionDef *FunctionDef `( @@`
+	ClassDef    *ClassDef    `| @@ )`
+}
+
+type Decorator struct {
+	Pos lexer.Position
+
+	Callable *AttributeAccess `"@" @@`
+}
+
+// FunctionDef defines a function. Methods of a class may have
+// a modifier:
+//
+//   статичний функція ф() {}           // is not bound to an instance
+//   класовий функція ф(к: тип) {}      // receives the class
+//   властивість функція ф(я: Клас) {}  // getter or setter of attribute
+//   абстрактний функція ф(я: Клас);    // has no body
+//
+// Generic functions declare type parameters, which are bound to types
+// of arguments on each call:
+//
+//   функція перший[Т](значення: список[Т]): Т { ... }
 type FunctionDef struct {
 	Pos lexer.Position
 
-	Name          string         `"функція" @Ident`
-	ParametersSet *ParametersSet `@@`
-	ReturnTypes   []*ReturnType  `[":" (@@ | ("(" (@@ ("," @@)+ )? ")"))]`
-	Body          *FunctionBody  `"{" @@ "}"`
+	Modifier       string         `@("статичний" | "класовий" | "властивість" | "абстрактний")?`
+	Name           string         `"функція" @Ident`
+	TypeParameters []string       `("[" @Ident ("," @Ident)* "]")?`
+	ParametersSet  *ParametersSet `@@`
+	ReturnTypes    []*ReturnType  `[":" (@@ | ("(" (@@ ("," @@)+ )? ")"))]`
+	Body           *FunctionBody  `( "{" @@ "}" | ";" )`
 }
 
 type ParametersSet struct {
@@ -102,40 +211,142 @@ type ParametersSet struct {
 type Parameter struct {
 	Pos lexer.Position
 
-	Name       string `@Ident ":"`
-	Type       string `@Ident`
-	IsNullable bool   `@"?"?`
+	Name       string      `@Ident ":"`
+	Type       *Annotation `@@`
+	IsNullable bool        `@"?"?`
 }
 
 type ReturnType struct {
 	Pos lexer.Position
 
-	Name       string `@Ident`
-	IsNullable bool   `@"?"?`
+	Type       *Annotation `@@`
+	IsNullable bool        `@"?"?`
 }
 
+// Annotation is an expected type of a value, several types can be
+// joined into a union. The value of a union type should be of one
+// of its types.
+//
+//   цілий | дійсний
+type Annotation struct {
+	Pos lexer.Position
+
+	Types []*TypeName `@@ ("|" @@)*`
+}
+
+// TypeName refers to a type, generic types may be parametrized
+// with type arguments.
+//
+//   список[цілий]
+//   словник[рядок, список[дійсний?]]
+type TypeName struct {
+	Pos lexer.Position
+
+	Name      string          `@Ident`
+	Arguments []*TypeArgument `("[" @@ ("," @@)* "]")?`
+}
+
+type TypeArgument struct {
+	Pos lexer.Position
+
+	Type       *Annotation `@@`
+	IsNullable bool        `@"?"?`
+}
+
+// ClassDef defines a class. Generic classes declare type parameters,
+// which are bound for each instance by the first call of its method:
+//
+//   клас Коробка[Т] {
+//       функція __конструктор__(я: Коробка, значення: Т) { ... }
+//   }
 type ClassDef struct {
 	Pos lexer.Position
 
-	Name    string         `"клас" @Ident`
-	IsFinal bool           `@"заключний"?`
+	Name           string         `"клас" @Ident`
+	TypeParameters []string       `("[" @Ident ("," @Ident)* "]")?`
+	IsFinal        bool           `@"заключний"?`
+	IsAbstract     bool           `@"абстрактний"?`
+	Bases          []string       `[":" (@Ident)+]`
+	Members        []*ClassMember `"{" @@* "}"`
+}
+
+// InterfaceDef declares methods which a class should implement
+// to conform the interface.
+//
+//   інтерфейс Фігура {
+//       функція площа(я: Фігура): дійсний;
+//   }
+//
+//   клас Коло : Фігура {
+//       функція площа(я: Коло): дійсний { ... }
+//   }
+type InterfaceDef struct {
+	Pos lexer.Position
+
+	Name    string         `"інтерфейс" @Ident`
 	Bases   []string       `[":" (@Ident)+]`
-	Members []*ClassMember `"{" @@* "}"`
+	Methods []*FunctionDef `"{" @@* "}"`
+}
+
+// EnumDef declares an enumeration, which is a final class with
+// a fixed set of members. Values of members are their ordinals
+// unless specified explicitly, a member without the value after
+// an integer one gets the next integer.
+//
+//   перелік Колір {
+//       ЧЕРВОНИЙ,
+//       ЗЕЛЕНИЙ = "зелений",
+//       СИНІЙ
+//   }
+type EnumDef struct {
+	Pos lexer.Position
+
+	Name    string        `"перелік" @Ident`
+	Members []*EnumMember `"{" (@@ ("," @@)* ","?)? "}"`
+}
+
+type EnumMember struct {
+	Pos lexer.Position
+
+	Name  string      `@Ident`
+	Value *Expression `("=" @@)?`
+}
+
+// RecordDef declares a final class with typed read-only fields.
+// Constructor, comparison operators, hashing, representation and
+// 'копія' method, which copies the record with changed fields, are
+// generated.
+//
+//   запис Точка {
+//       х: цілий;
+//       у: цілий;
+//   }
+//
+//   т = Точка(1, 2);
+//   друкр(т.копія({"у": 3}));  // Точка(х=1, у=3)
+type RecordDef struct {
+	Pos lexer.Position
+
+	Name    string         `"запис" @Ident`
+	Fields  []*Parameter   `"{" (@@ ";")*`
+	Members []*ClassMember `@@* "}"`
 }
 
 type ClassMember struct {
 	Pos lexer.Position
 
-	Variable *Assignment  ` (@@ ";")`
-	Method   *FunctionDef `| @@`
-	Class    *ClassDef    `| @@`
+	Constant  *ConstDef     ` @@`
+	Variable  *Assignment   `| (@@ ";")`
+	Decorated *DecoratedDef `| @@`
+	Method    *FunctionDef  `| @@`
+	Class     *ClassDef     `| @@`
 }
 
 type Assignment struct {
 	Pos lexer.Position
 
 	Expressions []*Expression ` @@ ("," @@)*`
-	Op          string        `[@"="`
+	Op          string        `[@("=" | "*""*""=" | ("+" | "-" | "*" | "/" | "%" | "&" | "^" | "|")"=" | "<""<""=" | ">"">""=")`
 	Next        []*Expression ` @@ ("," @@)*]`
 }
 
@@ -246,10 +457,22 @@ type Primary struct {
 
 	Constant        *Constant        `  @@`
 	LambdaDef       *LambdaDef       `| @@`
+	Yield           *YieldExpr       `| @@`
 	AttributeAccess *AttributeAccess `| @@`
 	SubExpression   *Expression      `| "(" @@ ")"`
 }
 
+// YieldExpr suspends the generator and passes the value
+// to the caller. The result is the value sent back to
+// the generator, or 'нуль'.
+//
+//   отримане = видати значення;
+type YieldExpr struct {
+	Pos lexer.Position
+
+	Value *Expression `"видати" @@?`
+}
+
 type Constant struct {
 	Pos lexer.Position
 
@@ -10,18 +10,34 @@ import (
 func (s *Stmt) String() string {
 	if s.IfStmt != nil {
 		return "s.IfStmt."
+	} else if s.SwitchStmt != nil {
+		return "s.SwitchStmt."
 	} else if s.LoopStmt != nil {
 		return "s.LoopStmt."
 	} else if s.Block != nil {
 		return "s.Block."
+	} else if s.Decorated != nil {
+		return s.Decorated.String()
 	} else if s.FunctionDef != nil {
 		return "s.FunctionDef."
 	} else if s.ClassDef != nil {
 		return "s.ClassDef."
+	} else if s.InterfaceDef != nil {
+		return "s.InterfaceDef."
+	} else if s.EnumDef != nil {
+		return "s.EnumDef."
+	} else if s.RecordDef != nil {
+		return "s.RecordDef."
 	} else if s.ReturnStmt != nil {
 		return "повернути ..."
 	} else if s.BreakStmt {
 		return "перервати"
+	} else if s.ConstDef != nil {
+		return s.ConstDef.String()
+	} else if s.VarDef != nil {
+		return s.VarDef.String()
+	} else if s.TypeAliasDef != nil {
+		return s.TypeAliasDef.String()
 	} else if s.Assignment != nil {
 		return s.Assignment.String() + ";"
 	} else if s.Empty {
@@ -31,6 +47,74 @@ func (s *Stmt) String() string {
 	panic("unreachable")
 }
 
+func (d *DecoratedDef) String() string {
+	var decorators []string
+	for _, decorator := range d.Decorators {
+		decorators = append(decorators, decorator.String())
+	}
+
+	return strings.Join(decorators, " ")
+}
+
+func (d *Decorator) String() string {
+	return "@" + d.Callable.String()
+}
+
+func (c *ConstDef) String() string {
+	return fmt.Sprintf("стала %s = %s;", c.Name, c.Value.String())
+}
+
+func (v *VarDef) String() string {
+	result := fmt.Sprintf("змінна %s: %s", v.Name, v.Type.String())
+	if v.IsNullable {
+		result += "?"
+	}
+
+	if v.Value != nil {
+		result += " = " + v.Value.String()
+	}
+
+	return result + ";"
+}
+
+func (a *TypeAliasDef) String() string {
+	if a.IsNullable {
+		return fmt.Sprintf("тип %s = %s?;", a.Name, a.Type.String())
+	}
+
+	return fmt.Sprintf("тип %s = %s;", a.Name, a.Type.String())
+}
+
+func (a *Annotation) String() string {
+	var names []string
+	for _, typeName := range a.Types {
+		names = append(names, typeName.String())
+	}
+
+	return strings.Join(names, " | ")
+}
+
+func (t *TypeName) String() string {
+	if len(t.Arguments) == 0 {
+		return t.Name
+	}
+
+	var arguments []string
+	for _, argument := range t.Arguments {
+		arguments = append(arguments, argument.String())
+	}
+
+	return fmt.Sprintf("%s[%s]", t.Name, strings.Join(arguments, ", "))
+}
+
+func (a *TypeArgument) String() string {
+	if a.IsNullable {
+		return a.Type.String() + "?"
+	}
+
+	return a.Type.String()
+}
+
 func (a *Assignment) String() string {
 	var lhs []string
 	for _, expr := range a.Expressions {
@@ -112,6 +196,8 @@ func (o *Primary) String() string {
 		return o.Constant.String()
 	case o.LambdaDef != nil:
 		return o.LambdaDef.String()
+	case o.Yield != nil:
+		return o.Yield.String()
 	case o.AttributeAccess != nil:
 		return o.AttributeAccess.String()
 	case o.SubExpression != nil:
@@ -121,6 +207,14 @@ func (o *Primary) String() string {
 	}
 }
 
+func (o *YieldExpr) String() string {
+	if o.Value != nil {
+		return "видати " + o.Value.String()
+	}
+
+	return "видати"
+}
+
 func (o *Constant) String() string {
 	switch {
 	case o.Integer != nil:
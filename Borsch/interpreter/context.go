@@ -3,6 +3,7 @@ package interpreter
 import (
 	"fmt"
 
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
@@ -10,6 +11,15 @@ import (
 
 type ContextImpl struct {
 	scopes []map[string]common.Value
+
+	// constants maps names of constants to indices of scopes
+	// they are declared in.
+	constants map[string]int
+
+	// declarations maps indices of scopes to types of variables
+	// declared explicitly in them.
+	declarations map[int]map[string]common.TypeHint
+
 	// package_      *types.PackageInstance
 	classContext  common.Context
 	parentContext common.Context
@@ -28,6 +38,14 @@ func (c *ContextImpl) PopScope() map[string]common.Value {
 	lastScopeIdx := len(c.scopes) - 1
 	scope := c.scopes[lastScopeIdx]
 	c.scopes = c.scopes[:lastScopeIdx]
+	for name, idx := range c.constants {
+		if idx == lastScopeIdx {
+			delete(c.constants, name)
+		}
+	}
+
+	delete(c.declarations, lastScopeIdx)
+
 	return scope
 }
 
@@ -68,27 +86,23 @@ func (c *ContextImpl) SetVar(name string, value common.Value) error {
 	}
 
 	scopesLen := len(c.scopes)
-	for idx := 0; idx < scopesLen; idx++ {
+	for idx := scopesLen - 1; idx >= 0; idx-- {
 		if oldValue, ok := c.scopes[idx][name]; ok {
-			oldValuePrototype := oldValue.(types.ObjectInstance).GetClass()
-			if oldValuePrototype != value.(types.ObjectInstance).GetClass() && oldValuePrototype != types.Nil {
-				if idx == scopesLen-1 {
-					return util.RuntimeError(
-						fmt.Sprintf(
-							"неможливо записати значення типу '%s' у змінну '%s' з типом '%s'",
-							value.GetTypeName(), name, oldValue.GetTypeName(),
-						),
-					)
-				}
+			if constIdx, ok := c.constants[name]; ok && constIdx == idx {
+				return constantIsReadOnlyError(name)
+			}
 
-				// TODO: надрукувати нормальне попередження!
-				fmt.Println(
-					fmt.Sprintf(
-						"Увага: несумісні типи даних '%s' та '%s', змінна '%s' стає недоступною в поточному полі видимості",
-						value.GetTypeName(), oldValue.GetTypeName(), name,
-					),
-				)
-				break
+			if variableType, ok := c.declarations[idx][name]; ok {
+				if !variableType.Accepts(value) {
+					return incompatibleTypesError(name, variableType.GetTypeName(), value)
+				}
+			} else {
+				// type of a variable which is not declared explicitly is
+				// inferred from its first non-nil value
+				oldValuePrototype := oldValue.(types.ObjectInstance).GetClass()
+				if oldValuePrototype != value.(types.ObjectInstance).GetClass() && oldValuePrototype != types.Nil {
+					return incompatibleTypesError(name, oldValue.GetTypeName(), value)
+				}
 			}
 
 			c.scopes[idx][name] = value
@@ -96,10 +110,104 @@ func (c *ContextImpl) SetVar(name string, value common.Value) error {
 		}
 	}
 
+	if c.parentContext != nil && c.parentContext.IsConstant(name) {
+		return constantIsReadOnlyError(name)
+	}
+
 	c.scopes[scopesLen-1][name] = value
 	return nil
 }
 
+// DeclareVar creates a variable of the given type in the top scope.
+// The variable shadows ones with the same name from outer scopes, and
+// every value assigned to it later is checked against the type.
+func (c *ContextImpl) DeclareVar(name string, variableType common.TypeHint, value common.Value) error {
+	if c.IsConstant(name) {
+		return constantIsReadOnlyError(name)
+	}
+
+	scope := c.TopScope()
+	if _, ok := scope[name]; ok {
+		return util.RuntimeError(fmt.Sprintf("ідентифікатор '%s' вже визначений", name))
+	}
+
+	if !variableType.Accepts(value) {
+		return incompatibleTypesError(name, variableType.GetTypeName(), value)
+	}
+
+	if c.declarations == nil {
+		c.declarations = map[int]map[string]common.TypeHint{}
+	}
+
+	scopeIdx := len(c.scopes) - 1
+	if c.declarations[scopeIdx] == nil {
+		c.declarations[scopeIdx] = map[string]common.TypeHint{}
+	}
+
+	scope[name] = value
+	c.declarations[scopeIdx][name] = variableType
+	return nil
+}
+
+func incompatibleTypesError(name string, typeName string, value common.Value) error {
+	return util.RuntimeError(
+		fmt.Sprintf(
+			"неможливо записати значення типу '%s' у змінну '%s' з типом '%s'",
+			value.GetTypeName(), name, typeName,
+		),
+	)
+}
+
+// SetConst declares a constant in the top scope. Constants can not be
+// rebound in the scope or shadowed in nested ones.
+func (c *ContextImpl) SetConst(name string, value common.Value) error {
+	if c.IsConstant(name) {
+		return constantIsReadOnlyError(name)
+	}
+
+	scope := c.TopScope()
+	if _, ok := scope[name]; ok {
+		return util.RuntimeError(fmt.Sprintf("ідентифікатор '%s' вже визначений", name))
+	}
+
+	if c.constants == nil {
+		c.constants = map[string]int{}
+	}
+
+	scope[name] = value
+	c.constants[name] = len(c.scopes) - 1
+	return nil
+}
+
+func (c *ContextImpl) IsConstant(name string) bool {
+	switch name {
+	case "нуль", "нульовий":
+		return true
+	}
+
+	if _, ok := c.constants[name]; ok {
+		return true
+	}
+
+	return c.parentContext != nil && c.parentContext.IsConstant(name)
+}
+
+// topConstants returns names of constants declared in the top scope.
+func (c *ContextImpl) topConstants() []string {
+	var names []string
+	for name, idx := range c.constants {
+		if idx == len(c.scopes)-1 {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func constantIsReadOnlyError(name string) error {
+	return util.RuntimeError(fmt.Sprintf("неможливо змінити значення сталої '%s'", name))
+}
+
 func (c *ContextImpl) GetClass(name string) (common.Value, error) {
 	var variable common.Value
 	var err error
@@ -110,8 +218,14 @@ func (c *ContextImpl) GetClass(name string) (common.Value, error) {
 	}
 
 	if err == nil {
-		if _, ok := variable.(*types.Class); ok {
-			return variable, nil
+		switch value := variable.(type) {
+		case *types.Class:
+			return value, nil
+		case *types.FunctionInstance:
+			// 'тип' is both the function and the type of classes
+			if value == builtin.TypeFunction {
+				return types.TypeClass, nil
+			}
 		}
 	}
 
@@ -2,6 +2,7 @@ package interpreter
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
@@ -55,18 +56,48 @@ func (a *Assignment) Evaluate(state common.State) (common.Value, error) {
 		return a.Expressions[0].Evaluate(state, nil)
 	}
 
+	if a.Op != "=" {
+		return a.evalAugmented(state)
+	}
+
 	return unpack(state, a.Expressions, a.Next)
 }
 
+// evalAugmented executes the assignment like 'х += 1'. The in-place
+// operator of the value is called if it exists, the value is set to
+// the result of the binary operator otherwise.
+func (a *Assignment) evalAugmented(state common.State) (common.Value, error) {
+	if len(a.Expressions) != 1 || len(a.Next) != 1 {
+		return nil, util.RuntimeError(fmt.Sprintf("оператор '%s' очікує одну змінну та одне значення", a.Op))
+	}
+
+	left, err := a.Expressions[0].Evaluate(state, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := a.Next[0].Evaluate(state, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := types.CallInPlaceOperator(state, augmentedOperator(a.Op), left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Expressions[0].Evaluate(state, result)
+}
+
 // Evaluate executes LogicalAnd operation.
 // If `valueToSet` is nil, return variable or value from context,
 // set a new value or return an error otherwise.
 func (a *LogicalAnd) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
-	return evalBinaryOperator(state, valueToSet, common.AndOp.Name(), a.LogicalOr, a.Next)
+	return evalBinaryOperator(state, valueToSet, common.AndOp, a.LogicalOr, a.Next)
 }
 
 func (o *LogicalOr) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
-	return evalBinaryOperator(state, valueToSet, common.OrOp.Name(), o.LogicalNot, o.Next)
+	return evalBinaryOperator(state, valueToSet, common.OrOp, o.LogicalNot, o.Next)
 }
 
 func (a *LogicalNot) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
@@ -95,40 +126,40 @@ func (a *LogicalNot) Evaluate(state common.State, valueToSet common.Value) (comm
 func (a *Comparison) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
 	switch a.Op {
 	case ">=":
-		return evalBinaryOperator(state, valueToSet, common.GreaterOrEqualsOp.Name(), a.BitwiseOr, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.GreaterOrEqualsOp, a.BitwiseOr, a.Next)
 	case ">":
-		return evalBinaryOperator(state, valueToSet, common.GreaterOp.Name(), a.BitwiseOr, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.GreaterOp, a.BitwiseOr, a.Next)
 	case "<=":
-		return evalBinaryOperator(state, valueToSet, common.LessOrEqualsOp.Name(), a.BitwiseOr, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.LessOrEqualsOp, a.BitwiseOr, a.Next)
 	case "<":
-		return evalBinaryOperator(state, valueToSet, common.LessOp.Name(), a.BitwiseOr, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.LessOp, a.BitwiseOr, a.Next)
 	case "==":
-		return evalBinaryOperator(state, valueToSet, common.EqualsOp.Name(), a.BitwiseOr, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.EqualsOp, a.BitwiseOr, a.Next)
 	case "!=":
-		return evalBinaryOperator(state, valueToSet, common.NotEqualsOp.Name(), a.BitwiseOr, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.NotEqualsOp, a.BitwiseOr, a.Next)
 	default:
 		return a.BitwiseOr.Evaluate(state, valueToSet)
 	}
 }
 
 func (a *BitwiseOr) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
-	return evalBinaryOperator(state, valueToSet, common.BitwiseOrOp.Name(), a.BitwiseXor, a.Next)
+	return evalBinaryOperator(state, valueToSet, common.BitwiseOrOp, a.BitwiseXor, a.Next)
 }
 
 func (a *BitwiseXor) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
-	return evalBinaryOperator(state, valueToSet, common.BitwiseXorOp.Name(), a.BitwiseAnd, a.Next)
+	return evalBinaryOperator(state, valueToSet, common.BitwiseXorOp, a.BitwiseAnd, a.Next)
 }
 
 func (a *BitwiseAnd) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
-	return evalBinaryOperator(state, valueToSet, common.BitwiseAndOp.Name(), a.BitwiseShift, a.Next)
+	return evalBinaryOperator(state, valueToSet, common.BitwiseAndOp, a.BitwiseShift, a.Next)
 }
 
 func (a *BitwiseShift) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
 	switch a.Op {
 	case "<<":
-		return evalBinaryOperator(state, valueToSet, common.BitwiseLeftShiftOp.Name(), a.Addition, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.BitwiseLeftShiftOp, a.Addition, a.Next)
 	case ">>":
-		return evalBinaryOperator(state, valueToSet, common.BitwiseRightShiftOp.Name(), a.Addition, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.BitwiseRightShiftOp, a.Addition, a.Next)
 	default:
 		return a.Addition.Evaluate(state, valueToSet)
 	}
@@ -137,9 +168,9 @@ func (a *BitwiseShift) Evaluate(state common.State, valueToSet common.Value) (co
 func (a *Addition) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
 	switch a.Op {
 	case "+":
-		return evalBinaryOperator(state, valueToSet, common.AddOp.Name(), a.MultiplicationOrMod, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.AddOp, a.MultiplicationOrMod, a.Next)
 	case "-":
-		return evalBinaryOperator(state, valueToSet, common.SubOp.Name(), a.MultiplicationOrMod, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.SubOp, a.MultiplicationOrMod, a.Next)
 	default:
 		return a.MultiplicationOrMod.Evaluate(state, valueToSet)
 	}
@@ -148,11 +179,11 @@ func (a *Addition) Evaluate(state common.State, valueToSet common.Value) (common
 func (a *MultiplicationOrMod) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
 	switch a.Op {
 	case "/":
-		return evalBinaryOperator(state, valueToSet, common.DivOp.Name(), a.Unary, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.DivOp, a.Unary, a.Next)
 	case "*":
-		return evalBinaryOperator(state, valueToSet, common.MulOp.Name(), a.Unary, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.MulOp, a.Unary, a.Next)
 	case "%":
-		return evalBinaryOperator(state, valueToSet, common.ModuloOp.Name(), a.Unary, a.Next)
+		return evalBinaryOperator(state, valueToSet, common.ModuloOp, a.Unary, a.Next)
 	default:
 		return a.Unary.Evaluate(state, valueToSet)
 	}
@@ -172,7 +203,7 @@ func (a *Unary) Evaluate(state common.State, valueToSet common.Value) (common.Va
 }
 
 func (a *Exponent) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
-	return evalBinaryOperator(state, valueToSet, common.PowOp.Name(), a.Primary, a.Next)
+	return evalBinaryOperator(state, valueToSet, common.PowOp, a.Primary, a.Next)
 }
 
 func (a *Primary) Evaluate(state common.State, valueToSet common.Value) (common.Value, error) {
@@ -202,9 +233,35 @@ func (a *Primary) Evaluate(state common.State, valueToSet common.Value) (common.
 		return a.LambdaDef.Evaluate(state)
 	}
 
+	if a.Yield != nil {
+		if valueToSet != nil {
+			return nil, util.RuntimeError("неможливо присвоїти значення виразу 'видати'")
+		}
+
+		return a.Yield.Evaluate(state)
+	}
+
 	panic("unreachable")
 }
 
+func (y *YieldExpr) Evaluate(state common.State) (common.Value, error) {
+	generator := state.GetGenerator()
+	if generator == nil {
+		return nil, util.RuntimeError("'видати' за межами функції")
+	}
+
+	var value common.Value = types.NewNilInstance()
+	if y.Value != nil {
+		var err error
+		value, err = y.Value.Evaluate(state, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return generator.Yield(state, value)
+}
+
 func (c *Constant) Evaluate(state common.State) (common.Value, error) {
 	if c.Integer != nil {
 		return types.NewIntegerInstance(*c.Integer), nil
@@ -248,7 +305,7 @@ func (c *Constant) Evaluate(state common.State) (common.Value, error) {
 				return nil, err
 			}
 
-			if err := dict.SetElement(key, value); err != nil {
+			if err := dict.SetElement(state, key, value); err != nil {
 				return nil, err
 			}
 		}
@@ -342,9 +399,9 @@ func (s *SlicingOrSubscription) Evaluate(
 			}
 		} else if s.Ident != nil {
 			if len(s.Ranges) != 0 {
-				variable, err = getCurrentValue(state.GetContext(), prevValue, *s.Ident)
+				variable, err = getCurrentValue(state, prevValue, *s.Ident)
 			} else {
-				variable, err = setCurrentValue(state.GetContext(), prevValue, *s.Ident, valueToSet)
+				variable, err = setCurrentValue(state, prevValue, *s.Ident, valueToSet)
 			}
 
 			if err != nil {
@@ -370,7 +427,7 @@ func (s *SlicingOrSubscription) Evaluate(
 			return nil, err
 		}
 	} else if s.Ident != nil {
-		variable, err = getCurrentValue(state.GetContext(), prevValue, *s.Ident)
+		variable, err = getCurrentValue(state, prevValue, *s.Ident)
 		if err != nil {
 			return nil, err
 		}
@@ -386,8 +443,7 @@ func (s *SlicingOrSubscription) Evaluate(
 }
 
 func (s *SlicingOrSubscription) callFunction(state common.State, prevValue common.Value) (common.Value, error) {
-	ctx := state.GetContext()
-	variable, err := getCurrentValue(ctx, prevValue, s.Call.Ident)
+	variable, err := getCurrentValue(state, prevValue, s.Call.Ident)
 	if err != nil {
 		return nil, err
 	}
@@ -413,7 +469,8 @@ func (l *LambdaDef) Evaluate(state common.State) (common.Value, error) {
 		return nil, err
 	}
 
-	returnTypes, err := evalReturnTypes(state, l.ReturnTypes)
+	isGenerator := l.Body.IsGenerator()
+	returnTypes, err := evalReturnTypes(state, l.ReturnTypes, isGenerator)
 	if err != nil {
 		return nil, err
 	}
@@ -421,15 +478,14 @@ func (l *LambdaDef) Evaluate(state common.State) (common.Value, error) {
 	lambda := types.NewFunctionInstance(
 		common.LambdaSignature,
 		arguments,
-		func(state common.State, _ *[]common.Value, kwargs *map[string]common.Value) (common.Value, error) {
-			return l.Body.Evaluate(state)
-		},
+		l.Body.makeHandler(common.LambdaSignature, isGenerator),
 		returnTypes,
 		false,
 		state.GetCurrentPackage().(*types.PackageInstance),
 		"", // TODO: add doc
 	)
 
+	lambda.SetContext(state.GetContext())
 	if l.InstantCall {
 		return l.evalInstantCall(state, lambda)
 	}
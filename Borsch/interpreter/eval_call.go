@@ -13,53 +13,47 @@ func (a *Call) Evaluate(
 	isLambda *bool,
 ) (common.Value, error) {
 	switch object := variable.(type) {
-	case *types.Class:
-		var args []common.Value
-		instance, err := object.GetEmptyInstance()
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = a.evalFunctionByName(state, instance, common.ConstructorName, &args, nil, true)
-		if err != nil {
-			return nil, err
-		}
-
-		return args[0], nil
 	case *types.FunctionInstance:
 		var args []common.Value
-		if selfInstance != nil {
-			switch selfInstance.(type) {
-			case *types.Class, *types.PackageInstance:
+		if selfInstance != nil && !object.IsStatic {
+			switch self := selfInstance.(type) {
+			case *types.PackageInstance:
 				// ignore
+			case *types.Class:
+				if object.IsClassMethod {
+					args = append(args, self)
+				}
 			case types.ObjectInstance:
-				args = append(args, selfInstance)
+				if isClassAttribute(state, self, a.Ident, object) {
+					if object.IsClassMethod {
+						args = append(args, self.GetClass())
+					} else {
+						args = append(args, selfInstance)
+					}
+				}
 			}
 		}
 
 		*isLambda = object.IsLambda()
 		return a.evalFunction(state, object, &args, nil)
-	case types.ObjectInstance:
-		args := []common.Value{variable}
-		return a.evalFunctionByName(state, object.GetClass(), common.CallOperatorName, &args, nil, true)
+	case *types.Class:
+		return a.evalObject(state, object)
 	default:
-		return nil, util.ObjectIsNotCallable(a.Ident, object.GetTypeName())
+		if !object.HasAttribute(common.CallOperatorName) {
+			return nil, util.ObjectIsNotCallable(a.Ident, object.GetTypeName())
+		}
+
+		return a.evalObject(state, object)
 	}
 }
 
-func (a *Call) evalFunctionByName(
-	state common.State,
-	object common.Value,
-	functionName string,
-	args *[]common.Value,
-	kwargs *map[string]common.Value,
-	isMethod bool,
-) (common.Value, error) {
-	if err := updateArgs(state, a.Arguments, args); err != nil {
+func (a *Call) evalObject(state common.State, object common.Value) (common.Value, error) {
+	var args []common.Value
+	if err := updateArgs(state, a.Arguments, &args); err != nil {
 		return nil, err
 	}
 
-	return types.CallByName(state, object, functionName, args, kwargs, isMethod)
+	return types.CallObject(state, object, &args)
 }
 
 func (a *Call) evalFunction(
@@ -74,3 +68,15 @@ func (a *Call) evalFunction(
 
 	return types.Call(state, function, args, kwargs)
 }
+
+// isClassAttribute checks if function is defined in the class of the
+// instance, functions stored in the instance itself are not bound to it.
+func isClassAttribute(
+	state common.State,
+	instance types.ObjectInstance,
+	name string,
+	function *types.FunctionInstance,
+) bool {
+	attribute, err := instance.GetClass().GetAttribute(state, name)
+	return err == nil && attribute == common.Value(function)
+}
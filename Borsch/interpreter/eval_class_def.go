@@ -8,15 +8,29 @@ import (
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
 )
 
-func (c *ClassDef) Evaluate(state common.State) (common.Value, error) {
+func (c *ClassDef) Evaluate(state common.State, decorators []*Decorator) (common.Value, error) {
 	ctx := state.GetContext()
 
+	if c.IsFinal && c.IsAbstract {
+		return nil, util.RuntimeError(
+			fmt.Sprintf("абстрактний клас '%s' не може бути заключним", c.Name),
+		)
+	}
+
+	typeScope, typeParameters, err := evalTypeParameters(state, c.TypeParameters)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: add doc
 	cls := &types.Class{
-		Name:    c.Name,
-		IsFinal: c.IsFinal,
-		Class:   nil,
-		Parent:  state.GetCurrentPackage(),
+		Name:       c.Name,
+		IsFinal:    c.IsFinal,
+		IsAbstract: c.IsAbstract,
+		Class:      nil,
+		Parent:     state.GetCurrentPackage(),
+
+		TypeParameters: typeParameters,
 	}
 
 	for _, name := range c.Bases {
@@ -38,18 +52,35 @@ func (c *ClassDef) Evaluate(state common.State) (common.Value, error) {
 		cls.Bases = append(cls.Bases, baseClass)
 	}
 
+	if err := cls.Linearize(); err != nil {
+		return nil, err
+	}
+
 	cls.GetEmptyInstance = func() (common.Value, error) {
+		if cls.IsAbstract {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("неможливо створити об'єкт абстрактного класу '%s'", cls.GetName()),
+			)
+		}
+
 		return types.NewClassInstance(cls, map[string]common.Value{}), nil
 	}
 
-	err := ctx.SetVar(c.Name, cls)
-	if err != nil {
-		return nil, err
+	// the class itself and its type parameters are visible in the class
+	// body, but they are not attributes of the class; the name is bound
+	// in the enclosing scope once decorators are applied, since they
+	// may replace the class
+	classScope := map[string]common.Value{c.Name: cls}
+	for name, parameter := range typeScope {
+		classScope[name] = parameter
 	}
 
+	typeContext := ctx.GetChild()
+	typeContext.PushScope(classScope)
 	classContext := ContextImpl{
-		scopes:       []map[string]common.Value{{}},
-		classContext: ctx,
+		scopes:        []map[string]common.Value{{}},
+		classContext:  typeContext,
+		parentContext: typeContext,
 	}
 
 	for _, classMember := range c.Members {
@@ -59,45 +90,138 @@ func (c *ClassDef) Evaluate(state common.State) (common.Value, error) {
 		}
 	}
 
-	cls.SetAttributes(classContext.PopScope())
+	cls.FreezeAttributes(classContext.topConstants()...)
+	cls.SetAttributes(bindMethods(cls, classContext.PopScope()))
+	cls.InheritOverloads()
 	cls.Setup()
 	if !cls.IsValid() {
 		panic("custom class is invalid")
 	}
 
-	return cls, nil
+	if err := cls.CheckImplementation(); err != nil {
+		return nil, err
+	}
+
+	var value common.Value = cls
+	if len(decorators) != 0 {
+		value, err = applyDecorators(state, decorators, cls)
+		if err != nil {
+			return nil, err
+		}
+
+		// methods refer to the decorated value by the class name
+		classScope[c.Name] = value
+	}
+
+	return value, ctx.SetVar(c.Name, value)
+}
+
+// bindMethods makes methods know the class they are defined in,
+// so they are able to call methods of its bases via 'батько()'.
+func bindMethods(class *types.Class, attributes map[string]common.Value) map[string]common.Value {
+	var bind func(value common.Value)
+	bind = func(value common.Value) {
+		switch attribute := value.(type) {
+		case *types.FunctionInstance:
+			attribute.DefiningClass = class
+			for _, overload := range attribute.Overloads() {
+				overload.DefiningClass = class
+			}
+		case *types.PropertyInstance:
+			if attribute.Getter != nil {
+				bind(attribute.Getter)
+			}
+
+			if attribute.Setter != nil {
+				bind(attribute.Setter)
+			}
+		}
+	}
+
+	for _, attribute := range attributes {
+		bind(attribute)
+	}
+
+	return attributes
 }
 
 func (m *ClassMember) Evaluate(state common.State, class *types.Class) (common.Value, error) {
+	if m.Constant != nil {
+		return m.Constant.Evaluate(state)
+	}
+
 	if m.Variable != nil {
 		return m.Variable.Evaluate(state)
 	}
 
 	if m.Method != nil {
-		return m.Method.Evaluate(
-			state,
-			state.GetCurrentPackage().(*types.PackageInstance),
-			func(arguments []types.FunctionParameter, returnTypes []types.FunctionReturnType) error {
-				if err := checkMethod(class, arguments, returnTypes); err != nil {
-					return err
-				}
+		return evalMethod(state, class, m.Method, nil)
+	}
 
-				if m.Method.Name == common.ConstructorName {
-					return checkConstructor(arguments, returnTypes)
-				}
+	if m.Decorated != nil {
+		if m.Decorated.FunctionDef != nil {
+			return evalMethod(state, class, m.Decorated.FunctionDef, m.Decorated.Decorators)
+		}
 
-				return nil
-			},
-		)
+		return m.Decorated.ClassDef.Evaluate(state, m.Decorated.Decorators)
 	}
 
 	if m.Class != nil {
-		return m.Class.Evaluate(state)
+		return m.Class.Evaluate(state, nil)
 	}
 
 	panic("unreachable")
 }
 
+func evalMethod(
+	state common.State,
+	class *types.Class,
+	method *FunctionDef,
+	decorators []*Decorator,
+) (common.Value, error) {
+	return method.Evaluate(
+		state,
+		state.GetCurrentPackage().(*types.PackageInstance),
+		func(arguments []types.FunctionParameter, returnTypes []types.FunctionReturnType) error {
+			if len(method.Modifier) != 0 && method.Name == common.ConstructorName {
+				return util.RuntimeError(
+					fmt.Sprintf("конструктор не може мати модифікатор '%s'", method.Modifier),
+				)
+			}
+
+			if (method.Body == nil) != (method.Modifier == abstractModifier) {
+				if method.Body == nil {
+					return util.RuntimeError(fmt.Sprintf("метод '%s' не має тіла", method.Name))
+				}
+
+				return util.RuntimeError(fmt.Sprintf("абстрактний метод '%s' не може мати тіла", method.Name))
+			}
+
+			switch method.Modifier {
+			case staticModifier:
+				return nil
+			case classMethodModifier:
+				return checkClassMethod(method.Name, arguments)
+			}
+
+			if err := checkMethod(class, arguments, returnTypes); err != nil {
+				return err
+			}
+
+			if method.Modifier == propertyModifier {
+				return checkProperty(method.Name, arguments, returnTypes)
+			}
+
+			if method.Name == common.ConstructorName {
+				return checkConstructor(arguments, returnTypes)
+			}
+
+			return nil
+		},
+		decorators,
+	)
+}
+
 func checkMethod(class *types.Class, args []types.FunctionParameter, _ []types.FunctionReturnType) error {
 	if len(args) == 0 {
 		// TODO: ukr error text!
@@ -117,6 +241,48 @@ func checkMethod(class *types.Class, args []types.FunctionParameter, _ []types.F
 	return nil
 }
 
+// checkClassMethod checks if the method receives the class it is called
+// with, so the first parameter should be of type 'тип'.
+func checkClassMethod(name string, args []types.FunctionParameter) error {
+	if len(args) == 0 || args[0].Type != types.TypeClass {
+		actual := "нічого"
+		if len(args) != 0 {
+			actual = args[0].GetTypeName()
+		}
+
+		return util.RuntimeError(
+			fmt.Sprintf(
+				"перший параметр класового методу '%s' має бути типу '%s', отримано '%s'",
+				name, common.TypeTypeName, actual,
+			),
+		)
+	}
+
+	return nil
+}
+
+func checkProperty(name string, args []types.FunctionParameter, returnTypes []types.FunctionReturnType) error {
+	switch len(args) {
+	case 1:
+		if len(returnTypes) != 1 {
+			return util.RuntimeError(
+				fmt.Sprintf("метод читання властивості '%s' має повертати одне значення", name),
+			)
+		}
+	case 2:
+		// setter
+	default:
+		return util.RuntimeError(
+			fmt.Sprintf(
+				"метод властивості '%s' має приймати лише об'єкт або об'єкт та нове значення",
+				name,
+			),
+		)
+	}
+
+	return nil
+}
+
 func checkConstructor(_ []types.FunctionParameter, returnTypes []types.FunctionReturnType) error {
 	switch len(returnTypes) {
 	case 0:
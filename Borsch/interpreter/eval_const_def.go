@@ -0,0 +1,14 @@
+package interpreter
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func (c *ConstDef) Evaluate(state common.State) (common.Value, error) {
+	value, err := c.Value.Evaluate(state, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, state.GetContext().SetConst(c.Name, value)
+}
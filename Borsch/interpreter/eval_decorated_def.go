@@ -0,0 +1,53 @@
+package interpreter
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func (d *DecoratedDef) Evaluate(state common.State) (common.Value, error) {
+	if d.FunctionDef != nil {
+		return d.FunctionDef.Evaluate(
+			state,
+			state.GetCurrentPackage().(*types.PackageInstance),
+			nil,
+			d.Decorators,
+		)
+	}
+
+	if d.ClassDef != nil {
+		return d.ClassDef.Evaluate(state, d.Decorators)
+	}
+
+	panic("unreachable")
+}
+
+func (d *Decorator) Evaluate(state common.State) (common.Value, error) {
+	return d.Callable.Evaluate(state, nil, nil)
+}
+
+// applyDecorators evaluates decorators in order of appearance, then
+// passes the value through them starting from the one closest to
+// the definition.
+func applyDecorators(state common.State, decorators []*Decorator, value common.Value) (common.Value, error) {
+	var callables []common.Value
+	for _, decorator := range decorators {
+		callable, err := decorator.Evaluate(state)
+		if err != nil {
+			return nil, err
+		}
+
+		callables = append(callables, callable)
+	}
+
+	for i := len(callables) - 1; i >= 0; i-- {
+		var err error
+		value, err = types.CallObject(state, callables[i], &[]common.Value{value})
+		if err != nil {
+			state.GetInterpreter().Trace(decorators[i].Pos, decorators[i].Callable.String(), decorators[i].String())
+			return nil, err
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,39 @@
+package interpreter
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func (e *EnumDef) Evaluate(state common.State) (common.Value, error) {
+	var names []string
+	var values []common.Value
+	var next int64
+	for i, member := range e.Members {
+		var value common.Value = types.NewIntegerInstance(next)
+		if member.Value != nil {
+			var err error
+			value, err = member.Value.Evaluate(state, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// implicit values continue from the previous integer value
+		if integer, ok := value.(types.IntegerInstance); ok {
+			next = integer.Value + 1
+		} else {
+			next = int64(i) + 1
+		}
+
+		names = append(names, member.Name)
+		values = append(values, value)
+	}
+
+	enum, err := types.NewEnumClass(e.Name, state.GetCurrentPackage(), names, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return enum, state.GetContext().SetVar(e.Name, enum)
+}
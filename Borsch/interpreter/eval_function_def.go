@@ -1,21 +1,61 @@
 package interpreter
 
 import (
+	"fmt"
+	"reflect"
+
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+// Method modifiers
+const (
+	staticModifier      = "статичний"
+	classMethodModifier = "класовий"
+	propertyModifier    = "властивість"
+	abstractModifier    = "абстрактний"
 )
 
 func (f *FunctionDef) Evaluate(
 	state common.State,
 	parentPackage *types.PackageInstance,
 	check func([]types.FunctionParameter, []types.FunctionReturnType) error,
+	decorators []*Decorator,
 ) (common.Value, error) {
-	arguments, err := f.ParametersSet.Evaluate(state)
+	if check == nil {
+		if len(f.Modifier) != 0 {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("модифікатор '%s' можна застосувати лише до методу класу", f.Modifier),
+			)
+		}
+
+		if f.Body == nil {
+			return nil, util.RuntimeError(fmt.Sprintf("функція '%s' не має тіла", f.Name))
+		}
+	}
+
+	typeScope, typeParameters, err := evalTypeParameters(state, f.TypeParameters)
 	if err != nil {
 		return nil, err
 	}
 
-	returnTypes, err := evalReturnTypes(state, f.ReturnTypes)
+	signatureState := state
+	if len(typeParameters) != 0 {
+		// type parameters are visible only in the signature and
+		// bound to types of arguments in the body
+		ctx := state.GetContext().GetChild()
+		ctx.PushScope(typeScope)
+		signatureState = state.WithContext(ctx)
+	}
+
+	arguments, err := f.ParametersSet.Evaluate(signatureState)
+	if err != nil {
+		return nil, err
+	}
+
+	isGenerator := f.Body != nil && f.Body.IsGenerator()
+	returnTypes, err := evalReturnTypes(signatureState, f.ReturnTypes, isGenerator)
 	if err != nil {
 		return nil, err
 	}
@@ -26,18 +66,105 @@ func (f *FunctionDef) Evaluate(
 		}
 	}
 
+	var handler func(common.State, *[]common.Value, *map[string]common.Value) (common.Value, error)
+	if f.Body != nil {
+		handler = f.Body.makeHandler(f.Name, isGenerator)
+	} else {
+		handler = makeAbstractHandler(f.Name)
+	}
+
 	function := types.NewFunctionInstance(
 		f.Name,
 		arguments,
-		func(state common.State, _ *[]common.Value, kwargs *map[string]common.Value) (common.Value, error) {
-			return f.Body.Evaluate(state)
-		},
+		handler,
 		returnTypes,
 		parentPackage == nil,
 		parentPackage,
 		"", // TODO: add doc
 	)
-	return function, state.GetContext().SetVar(f.Name, function)
+
+	function.IsAbstract = f.Body == nil
+	function.TypeParameters = typeParameters
+	if check == nil {
+		function.SetContext(state.GetContext())
+	}
+
+	value, err := applyDecorators(state, decorators, function)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = f.applyModifier(state, value)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err = overload(state.GetContext(), f.Name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, state.GetContext().SetVar(f.Name, value)
+}
+
+// overload adds the function to functions with the same name
+// defined in the current scope. Overloads of methods defined in bases
+// are merged when the class is created, see Class.InheritOverloads.
+func overload(ctx common.Context, name string, value common.Value) (common.Value, error) {
+	function, ok := value.(*types.FunctionInstance)
+	if !ok {
+		return value, nil
+	}
+
+	existing, ok := ctx.TopScope()[name].(*types.FunctionInstance)
+	if !ok {
+		return value, nil
+	}
+
+	return existing.Overload(function)
+}
+
+// applyModifier marks the method as static or class one, methods of
+// a property are collected into the property with the same name.
+func (f *FunctionDef) applyModifier(state common.State, method common.Value) (common.Value, error) {
+	switch f.Modifier {
+	case "", abstractModifier:
+		return method, nil
+	case staticModifier, classMethodModifier:
+		function, ok := method.(*types.FunctionInstance)
+		if !ok {
+			if f.Modifier == staticModifier {
+				// other callable objects are never bound to an instance
+				return method, nil
+			}
+
+			return nil, util.RuntimeError(
+				fmt.Sprintf(
+					"модифікатор '%s' потребує функцію, отримано '%s'",
+					f.Modifier, method.GetTypeName(),
+				),
+			)
+		}
+
+		function.IsStatic = f.Modifier == staticModifier
+		function.IsClassMethod = f.Modifier == classMethodModifier
+		return function, nil
+	case propertyModifier:
+		property, ok := state.GetContext().TopScope()[f.Name].(*types.PropertyInstance)
+		if !ok {
+			property = types.NewPropertyInstance(f.Name)
+		}
+
+		if len(f.ParametersSet.Parameters) == 1 {
+			property.Getter = method
+		} else {
+			property.Setter = method
+		}
+
+		return property, nil
+	}
+
+	panic("unreachable")
 }
 
 func (p *ParametersSet) Evaluate(state common.State) ([]types.FunctionParameter, error) {
@@ -56,33 +183,139 @@ func (p *ParametersSet) Evaluate(state common.State) ([]types.FunctionParameter,
 }
 
 func (p *Parameter) Evaluate(ctx common.Context) (*types.FunctionParameter, error) {
-	class, err := ctx.GetClass(p.Type)
+	class, typeArguments, err := p.Type.Evaluate(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.FunctionParameter{
-		Type:       class.(*types.Class),
-		Name:       p.Name,
-		IsVariadic: false,
-		IsNullable: p.IsNullable,
+		Type:          class,
+		Name:          p.Name,
+		IsVariadic:    false,
+		IsNullable:    p.IsNullable,
+		TypeArguments: typeArguments,
 	}, nil
 }
 
+func (a *Annotation) Evaluate(ctx common.Context) (*types.Class, []types.TypeArgument, error) {
+	if len(a.Types) == 1 {
+		return a.Types[0].Evaluate(ctx)
+	}
+
+	var members []types.TypeArgument
+	for _, typeName := range a.Types {
+		class, typeArguments, err := typeName.Evaluate(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		members = append(
+			members, types.TypeArgument{
+				Type:          class,
+				IsNullable:    false,
+				TypeArguments: typeArguments,
+			},
+		)
+	}
+
+	return types.NewUnionType("", members, types.BuiltinPackage), nil, nil
+}
+
+func (t *TypeName) Evaluate(ctx common.Context) (*types.Class, []types.TypeArgument, error) {
+	value, err := ctx.GetClass(t.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var typeArguments []types.TypeArgument
+	for _, argument := range t.Arguments {
+		class, classArguments, err := argument.Type.Evaluate(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		typeArguments = append(
+			typeArguments, types.TypeArgument{
+				Type:          class,
+				IsNullable:    argument.IsNullable,
+				TypeArguments: classArguments,
+			},
+		)
+	}
+
+	class := value.(*types.Class)
+	if err := class.CheckTypeArguments(typeArguments); err != nil {
+		return nil, nil, err
+	}
+
+	return class, typeArguments, nil
+}
+
+// evalTypeParameters creates type parameters of a generic class or
+// function, returns them with the scope they can be referred by name in.
+func evalTypeParameters(state common.State, names []string) (map[string]common.Value, []*types.Class, error) {
+	scope := map[string]common.Value{}
+	var typeParameters []*types.Class
+	for _, name := range names {
+		if _, ok := scope[name]; ok {
+			return nil, nil, util.RuntimeError(fmt.Sprintf("параметр типу '%s' вже визначений", name))
+		}
+
+		typeParameter := types.NewTypeParameter(name, state.GetCurrentPackage())
+		scope[name] = typeParameter
+		typeParameters = append(typeParameters, typeParameter)
+	}
+
+	return scope, typeParameters, nil
+}
+
 func (b *FunctionBody) Evaluate(state common.State) (common.Value, error) {
 	result := b.Stmts.Evaluate(state, true, false)
 	return result.Value, result.Err
 }
 
+// IsGenerator checks if the body contains 'видати' expression,
+// nested functions, lambdas and classes are not taken into account.
+func (b *FunctionBody) IsGenerator() bool {
+	return containsYield(reflect.ValueOf(b.Stmts))
+}
+
+func (b *FunctionBody) makeHandler(name string, isGenerator bool) func(
+	common.State,
+	*[]common.Value,
+	*map[string]common.Value,
+) (common.Value, error) {
+	if isGenerator {
+		return func(state common.State, _ *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+			return types.NewGeneratorInstance(name, state, b.Evaluate), nil
+		}
+	}
+
+	return func(state common.State, _ *[]common.Value, _ *map[string]common.Value) (common.Value, error) {
+		return b.Evaluate(state)
+	}
+}
+
+func makeAbstractHandler(name string) func(
+	common.State,
+	*[]common.Value,
+	*map[string]common.Value,
+) (common.Value, error) {
+	return func(common.State, *[]common.Value, *map[string]common.Value) (common.Value, error) {
+		return nil, util.RuntimeError(fmt.Sprintf("абстрактний метод '%s' не має реалізації", name))
+	}
+}
+
 func (t *ReturnType) Evaluate(ctx common.Context) (*types.FunctionReturnType, error) {
-	class, err := ctx.GetClass(t.Name)
+	class, typeArguments, err := t.Type.Evaluate(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.FunctionReturnType{
-		Type:       class.(*types.Class),
-		IsNullable: t.IsNullable,
+		Type:          class,
+		IsNullable:    t.IsNullable,
+		TypeArguments: typeArguments,
 	}, nil
 }
 
@@ -0,0 +1,93 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+func (i *InterfaceDef) Evaluate(state common.State) (common.Value, error) {
+	ctx := state.GetContext()
+	cls := &types.Class{
+		Name:        i.Name,
+		IsAbstract:  true,
+		IsInterface: true,
+		Class:       nil,
+		Parent:      state.GetCurrentPackage(),
+	}
+
+	for _, name := range i.Bases {
+		base, err := ctx.GetClass(name)
+		if err != nil {
+			return nil, err
+		}
+
+		baseClass := base.(*types.Class)
+		if !baseClass.IsInterface {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("інтерфейс '%s' може розширювати лише інтерфейси, '%s' не є інтерфейсом", i.Name, name),
+			)
+		}
+
+		cls.Bases = append(cls.Bases, baseClass)
+	}
+
+	if err := cls.Linearize(); err != nil {
+		return nil, err
+	}
+
+	cls.GetEmptyInstance = func() (common.Value, error) {
+		return nil, util.RuntimeError(fmt.Sprintf("неможливо створити об'єкт інтерфейсу '%s'", cls.GetName()))
+	}
+
+	// the interface is visible in its methods, but its name is bound in
+	// the enclosing scope only once the methods are checked
+	typeContext := ctx.GetChild()
+	typeContext.PushScope(map[string]common.Value{i.Name: cls})
+	interfaceContext := ContextImpl{
+		scopes:        []map[string]common.Value{{}},
+		classContext:  typeContext,
+		parentContext: typeContext,
+	}
+
+	for _, method := range i.Methods {
+		_, err := method.Evaluate(
+			state.WithContext(&interfaceContext),
+			state.GetCurrentPackage().(*types.PackageInstance),
+			func(arguments []types.FunctionParameter, returnTypes []types.FunctionReturnType) error {
+				if len(method.Modifier) != 0 {
+					return util.RuntimeError(
+						fmt.Sprintf(
+							"метод інтерфейсу '%s' не може мати модифікатор '%s'",
+							method.Name, method.Modifier,
+						),
+					)
+				}
+
+				if method.Body != nil {
+					return util.RuntimeError(fmt.Sprintf("метод інтерфейсу '%s' не може мати тіла", method.Name))
+				}
+
+				return checkMethod(cls, arguments, returnTypes)
+			},
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cls.SetAttributes(interfaceContext.PopScope())
+	cls.Setup()
+	if !cls.IsValid() {
+		panic("interface is invalid")
+	}
+
+	if err := cls.CheckImplementation(); err != nil {
+		return nil, err
+	}
+
+	return cls, ctx.SetVar(i.Name, cls)
+}
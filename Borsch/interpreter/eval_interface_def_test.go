@@ -0,0 +1,67 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+// evaluateCode evaluates the code in a new package and returns its
+// context, so the test can check which names are bound after an error.
+func evaluateCode(t *testing.T, code string) (common.Context, error) {
+	parser, err := NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ast, err := parser.Parse("тест.борщ", code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := NewInterpreter()
+	pkg := types.NewPackageInstance(i.rootContext.GetChild(), "тест.борщ", nil, nil)
+	state := NewState(parser, i, nil, nil)
+	_, err = ast.Evaluate(state.WithContext(pkg.GetContext()).WithPackage(pkg))
+	return pkg.GetContext(), err
+}
+
+func TestInterfaceDef_NotBoundIfInvalid(t *testing.T) {
+	ctx, err := evaluateCode(
+		t, `
+інтерфейс Фігура
+{
+    функція площа(я: Фігура): дійсний
+    {
+        повернути 0.0;
+    }
+}
+`,
+	)
+	if err == nil {
+		t.Fatal("expected an error for the method with a body")
+	}
+
+	if _, err := ctx.GetVar("Фігура"); err == nil {
+		t.Error("the invalid interface should not be bound")
+	}
+}
+
+func TestInterfaceDef_VisibleInMethods(t *testing.T) {
+	ctx, err := evaluateCode(
+		t, `
+інтерфейс Порівнюване
+{
+    функція більше(я: Порівнюване, інший: Порівнюване): логічний;
+}
+`,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ctx.GetVar("Порівнюване"); err != nil {
+		t.Error(err)
+	}
+}
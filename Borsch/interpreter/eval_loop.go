@@ -20,6 +20,10 @@ func (l *LoopStmt) Evaluate(state common.State, inFunction, inLoop bool) StmtRes
 }
 
 func (l *RangeBasedLoop) Evaluate(state common.State, body *BlockStmts, inFunction, inLoop bool) StmtResult {
+	if l.RightBound == nil {
+		return l.evaluateOverIterable(state, body, inFunction)
+	}
+
 	leftBound, err := getBound(state, l.LeftBound, "ліва")
 	if err != nil {
 		return StmtResult{Err: err}
@@ -53,6 +57,54 @@ func (l *RangeBasedLoop) Evaluate(state common.State, body *BlockStmts, inFuncti
 	return StmtResult{}
 }
 
+func (l *RangeBasedLoop) evaluateOverIterable(state common.State, body *BlockStmts, inFunction bool) StmtResult {
+	iterable, err := l.LeftBound.Evaluate(state, nil)
+	if err != nil {
+		return StmtResult{Err: err}
+	}
+
+	iterator, err := types.GetIterator(state, iterable)
+	if err != nil {
+		return StmtResult{Err: err}
+	}
+
+	ctx := state.GetContext()
+	for {
+		value, ok, err := iterator.Next(state)
+		if err != nil {
+			return StmtResult{Err: err}
+		}
+
+		if !ok {
+			break
+		}
+
+		ctx.PushScope(Scope{l.Variable: value})
+		result := body.Evaluate(state, inFunction, true)
+		if result.Err != nil {
+			_ = types.CloseIterator(iterable, iterator)
+			return result
+		}
+
+		ctx.PopScope()
+		switch result.State {
+		case StmtForceReturn, StmtBreak:
+			// the iterator is left before it is exhausted
+			if err := types.CloseIterator(iterable, iterator); err != nil {
+				return StmtResult{Err: err}
+			}
+
+			if result.State == StmtBreak {
+				result.State = StmtNone
+			}
+
+			return result
+		}
+	}
+
+	return StmtResult{}
+}
+
 func (l *ConditionalLoop) Evaluate(state common.State, body *BlockStmts, inFunction, inLoop bool) StmtResult {
 	ctx := state.GetContext()
 	for {
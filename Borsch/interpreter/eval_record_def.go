@@ -0,0 +1,78 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/util"
+)
+
+func (r *RecordDef) Evaluate(state common.State) (common.Value, error) {
+	ctx := state.GetContext()
+	cls := &types.Class{
+		Name:    r.Name,
+		IsFinal: true,
+		Class:   nil,
+		Parent:  state.GetCurrentPackage(),
+	}
+
+	cls.GetEmptyInstance = func() (common.Value, error) {
+		return types.NewClassInstance(cls, map[string]common.Value{}), nil
+	}
+
+	err := ctx.SetVar(r.Name, cls)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []types.FunctionParameter
+	var fieldNames []string
+	for _, field := range r.Fields {
+		for _, other := range fields {
+			if other.Name == field.Name {
+				return nil, util.RuntimeError(
+					fmt.Sprintf("поле '%s' вже визначене у записі '%s'", field.Name, r.Name),
+				)
+			}
+		}
+
+		parameter, err := field.Evaluate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, *parameter)
+		fieldNames = append(fieldNames, field.Name)
+	}
+
+	recordContext := ContextImpl{
+		scopes:        []map[string]common.Value{{}},
+		classContext:  ctx,
+		parentContext: ctx,
+	}
+
+	for _, member := range r.Members {
+		_, err := member.Evaluate(state.WithContext(&recordContext), cls)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cls.FreezeAttributes(fieldNames...)
+	cls.FreezeAttributes(recordContext.topConstants()...)
+
+	// methods defined in the record replace generated ones
+	cls.SetAttributes(
+		types.MergeAttributes(
+			types.MakeRecordAttributes(cls, fields),
+			bindMethods(cls, recordContext.PopScope()),
+		),
+	)
+	cls.Setup()
+	if !cls.IsValid() {
+		panic("record is invalid")
+	}
+
+	return cls, nil
+}
@@ -27,6 +27,8 @@ func (s *Stmt) Evaluate(state common.State, inFunction, inLoop bool) StmtResult
 	switch {
 	case s.IfStmt != nil:
 		return s.IfStmt.Evaluate(state, inFunction, inLoop)
+	case s.SwitchStmt != nil:
+		return s.SwitchStmt.Evaluate(state, inFunction, inLoop)
 	case s.LoopStmt != nil:
 		return s.LoopStmt.Evaluate(state, inFunction, inLoop)
 	case s.Block != nil:
@@ -39,20 +41,48 @@ func (s *Stmt) Evaluate(state common.State, inFunction, inLoop bool) StmtResult
 
 		ctx.PopScope()
 		return blockResult
+	case s.Decorated != nil:
+		value, err := s.Decorated.Evaluate(state)
+		if err != nil {
+			return StmtResult{Err: err}
+		}
+
+		return StmtResult{Value: value}
 	case s.FunctionDef != nil:
-		function, err := s.FunctionDef.Evaluate(state, state.GetCurrentPackage().(*types.PackageInstance), nil)
+		function, err := s.FunctionDef.Evaluate(state, state.GetCurrentPackage().(*types.PackageInstance), nil, nil)
 		if err != nil {
 			return StmtResult{Err: err}
 		}
 
 		return StmtResult{Value: function}
 	case s.ClassDef != nil:
-		class, err := s.ClassDef.Evaluate(state)
+		class, err := s.ClassDef.Evaluate(state, nil)
+		if err != nil {
+			return StmtResult{Err: err}
+		}
+
+		return StmtResult{Value: class}
+	case s.InterfaceDef != nil:
+		class, err := s.InterfaceDef.Evaluate(state)
 		if err != nil {
 			return StmtResult{Err: err}
 		}
 
 		return StmtResult{Value: class}
+	case s.EnumDef != nil:
+		enum, err := s.EnumDef.Evaluate(state)
+		if err != nil {
+			return StmtResult{Err: err}
+		}
+
+		return StmtResult{Value: enum}
+	case s.RecordDef != nil:
+		record, err := s.RecordDef.Evaluate(state)
+		if err != nil {
+			return StmtResult{Err: err}
+		}
+
+		return StmtResult{Value: record}
 	case s.ReturnStmt != nil:
 		if !inFunction {
 			return StmtResult{Err: errors.New("'повернути' за межами функції")}
@@ -66,6 +96,15 @@ func (s *Stmt) Evaluate(state common.State, inFunction, inLoop bool) StmtResult
 		}
 
 		return StmtResult{State: StmtBreak}
+	case s.ConstDef != nil:
+		result, err := s.ConstDef.Evaluate(state)
+		return StmtResult{Value: result, Err: err}
+	case s.VarDef != nil:
+		result, err := s.VarDef.Evaluate(state)
+		return StmtResult{Value: result, Err: err}
+	case s.TypeAliasDef != nil:
+		result, err := s.TypeAliasDef.Evaluate(state)
+		return StmtResult{Value: result, Err: err}
 	case s.Assignment != nil:
 		result, err := s.Assignment.Evaluate(state)
 		return StmtResult{Value: result, Err: err}
@@ -0,0 +1,63 @@
+package interpreter
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func (s *SwitchStmt) Evaluate(state common.State, inFunction, inLoop bool) StmtResult {
+	value, err := s.Value.Evaluate(state, nil)
+	if err != nil {
+		return StmtResult{Err: err}
+	}
+
+	for _, switchCase := range s.Cases {
+		matches, err := switchCase.Matches(state, value)
+		if err != nil {
+			return StmtResult{Err: err}
+		}
+
+		if matches {
+			return evalSwitchBody(state, switchCase.Body, inFunction, inLoop)
+		}
+	}
+
+	if s.Else != nil {
+		return evalSwitchBody(state, s.Else, inFunction, inLoop)
+	}
+
+	return StmtResult{}
+}
+
+// Matches checks if one of values of the case is equal to the value.
+func (c *SwitchCase) Matches(state common.State, value common.Value) (bool, error) {
+	for _, expression := range c.Values {
+		caseValue, err := expression.Evaluate(state, nil)
+		if err != nil {
+			return false, err
+		}
+
+		equals, err := types.Equals(state, value, caseValue)
+		if err != nil {
+			return false, err
+		}
+
+		if equals {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func evalSwitchBody(state common.State, body *BlockStmts, inFunction, inLoop bool) StmtResult {
+	ctx := state.GetContext()
+	ctx.PushScope(Scope{})
+	result := body.Evaluate(state, inFunction, inLoop)
+	if result.Err != nil {
+		return result
+	}
+
+	ctx.PopScope()
+	return result
+}
@@ -0,0 +1,33 @@
+package interpreter
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func (a *TypeAliasDef) Evaluate(state common.State) (common.Value, error) {
+	ctx := state.GetContext()
+	class, typeArguments, err := a.Type.Evaluate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var alias common.Value = class
+	if a.IsNullable || len(typeArguments) != 0 || len(a.Type.Types) > 1 {
+		// the alias is a union of the only annotated type,
+		// so it is named in messages about wrong types
+		alias = types.NewUnionType(
+			a.Name,
+			[]types.TypeArgument{
+				{
+					Type:          class,
+					IsNullable:    a.IsNullable,
+					TypeArguments: typeArguments,
+				},
+			},
+			state.GetCurrentPackage(),
+		)
+	}
+
+	return alias, ctx.SetVar(a.Name, alias)
+}
@@ -0,0 +1,32 @@
+package interpreter
+
+import (
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+func (v *VarDef) Evaluate(state common.State) (common.Value, error) {
+	ctx := state.GetContext()
+	class, typeArguments, err := v.Type.Evaluate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variableType := types.FunctionParameter{
+		Type:          class,
+		Name:          v.Name,
+		IsVariadic:    false,
+		IsNullable:    v.IsNullable,
+		TypeArguments: typeArguments,
+	}
+
+	var value common.Value = types.NewNilInstance()
+	if v.Value != nil {
+		value, err = v.Value.Evaluate(state, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return value, ctx.DeclareVar(v.Name, variableType, value)
+}
@@ -85,7 +85,7 @@ func (i *Interpreter) Import(state common.State, newPackagePath string) (
 	attrs := map[string]common.Value{}
 	if toExport, err := ctx.GetVar(common.ExportedAttributeName); err == nil {
 		switch exported := toExport.(type) {
-		case types.ListInstance:
+		case *types.ListInstance:
 			for _, value := range exported.Values {
 				if name, ok := value.(types.StringInstance); ok {
 					if attr, ok := scope[name.Value]; ok {
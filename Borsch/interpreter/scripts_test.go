@@ -0,0 +1,88 @@
+package interpreter
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
+)
+
+const (
+	scriptsDir           = "../../Test/перевірки"
+	libDir               = "../../Lib"
+	expectedErrorComment = "// очікувана помилка:"
+)
+
+// expectedError returns the error the script should fail with, it is
+// written in the first line of the script after expectedErrorComment.
+func expectedError(t *testing.T, path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	if scanner.Scan() && strings.HasPrefix(scanner.Text(), expectedErrorComment) {
+		return strings.TrimSpace(strings.TrimPrefix(scanner.Text(), expectedErrorComment))
+	}
+
+	return ""
+}
+
+func runScript(t *testing.T, path string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("паніка інтерпретатора: %v", r)
+		}
+	}()
+
+	parser, err := NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := NewInterpreter()
+	_, err = i.Import(NewState(parser, i, nil, nil), path)
+	return err
+}
+
+// TestScripts runs each script from scriptsDir, it passes if the script
+// finishes without errors or fails with the expected one.
+func TestScripts(t *testing.T) {
+	lib, err := filepath.Abs(libDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(common.BORSCH_LIB, lib)
+	scripts, err := filepath.Glob(filepath.Join(scriptsDir, "*.борщ"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, script := range scripts {
+		path, err := filepath.Abs(script)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(
+			filepath.Base(script), func(t *testing.T) {
+				expected := expectedError(t, path)
+				err := runScript(t, path)
+				switch {
+				case expected == "" && err != nil:
+					t.Errorf("неочікувана помилка:\n%s", err.Error())
+				case expected != "" && err == nil:
+					t.Errorf("очікувалась помилка '%s'", expected)
+				case expected != "" && !strings.Contains(err.Error(), expected):
+					t.Errorf("очікувалась помилка '%s', отримано:\n%s", expected, err.Error())
+				}
+			},
+		)
+	}
+}
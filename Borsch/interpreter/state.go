@@ -9,6 +9,7 @@ type StateImpl struct {
 	interpreter    common.Interpreter
 	context        common.Context
 	currentPackage common.Value
+	generator      common.GeneratorType
 }
 
 func NewState(
@@ -61,6 +62,14 @@ func (s *StateImpl) GetCurrentPackageOrNil() common.Value {
 	return s.currentPackage
 }
 
+// GetGenerator returns generator which evaluates current function
+// body, nil if the body is evaluated outside of a generator.
+func (s *StateImpl) GetGenerator() common.GeneratorType {
+	return s.generator
+}
+
+// WithContext returns a state for a new context, the generator
+// is not inherited, because each context belongs to its own call.
 func (s *StateImpl) WithContext(ctx common.Context) common.State {
 	return &StateImpl{
 		parser:         s.parser,
@@ -76,5 +85,16 @@ func (s *StateImpl) WithPackage(pkg common.Value) common.State {
 		interpreter:    s.interpreter,
 		context:        s.context,
 		currentPackage: pkg,
+		generator:      s.generator,
+	}
+}
+
+func (s *StateImpl) WithGenerator(generator common.GeneratorType) common.State {
+	return &StateImpl{
+		parser:         s.parser,
+		interpreter:    s.interpreter,
+		context:        s.context,
+		currentPackage: s.currentPackage,
+		generator:      generator,
 	}
 }
@@ -3,6 +3,7 @@ package interpreter
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/builtin/types"
 	"github.com/YuriyLisovskiy/borsch-lang/Borsch/common"
@@ -12,7 +13,7 @@ import (
 func evalBinaryOperator(
 	state common.State,
 	valueToSet common.Value,
-	operatorName string,
+	op common.Operator,
 	current common.OperatorEvaluatable,
 	next common.OperatorEvaluatable,
 ) (common.Value, error) {
@@ -27,17 +28,25 @@ func evalBinaryOperator(
 			return nil, err
 		}
 
-		operator, err := left.GetOperator(operatorName)
-		if err != nil {
-			return nil, err
-		}
-
-		return types.CallAttribute(state, left, operator, operatorName, &[]common.Value{right}, nil, true)
+		return types.CallBinaryOperator(state, op, left, right)
 	}
 
 	return left, nil
 }
 
+// augmentedOperator returns the binary operator of the augmented
+// assignment, e.g. '+' for '+='.
+func augmentedOperator(sign string) common.Operator {
+	sign = strings.TrimSuffix(sign, "=")
+	for op := common.PowOp; op <= common.LessOrEqualsOp; op++ {
+		if op.IsReflectable() && op.Sign() == sign {
+			return op
+		}
+	}
+
+	panic("unreachable")
+}
+
 func evalUnaryOperator(
 	state common.State,
 	operatorName string,
@@ -130,6 +139,32 @@ func evalSlicingOperation(
 			}
 		}
 
+		return evalSlicingOperation(state, element, ranges_[1:], valueToSet)
+	case *types.DictionaryInstance:
+		if ranges_[0].IsSlicing {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("неможливо застосувати оператор зрізу до об'єкта з типом '%s'", variable.GetTypeName()),
+			)
+		}
+
+		key, err := ranges_[0].LeftBound.Evaluate(state, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ranges_) == 1 && valueToSet != nil {
+			return iterable, iterable.SetElement(state, key, valueToSet)
+		}
+
+		element, err := iterable.GetElement(state, key)
+		if err != nil {
+			return nil, util.RuntimeError(err.Error())
+		}
+
+		if len(ranges_) == 1 {
+			return element, nil
+		}
+
 		return evalSlicingOperation(state, element, ranges_[1:], valueToSet)
 	default:
 		operatorDescription := ""
@@ -196,7 +231,7 @@ func unpack(state common.State, lhs []*Expression, rhs []*Expression) (common.Va
 
 	if i < len(sequence)-1 {
 		rest := types.NewListInstance()
-		rest.Values = sequence[i:]
+		rest.Values = append(rest.Values, sequence[i:]...)
 		list.Values = append(list.Values, rest)
 	} else {
 		element, err := lhs[i].Evaluate(state, sequence[i])
@@ -224,7 +259,7 @@ func getSequenceOrResult(state common.State, lhs []*Expression, rhs []*Expressio
 		}
 
 		switch list := element.(type) {
-		case types.ListInstance:
+		case *types.ListInstance:
 			if len(lhs) == 1 {
 				result, err := lhs[0].Evaluate(state, list)
 				if err != nil {
@@ -259,7 +294,7 @@ func unpackList(state common.State, lhs []*Expression, rhs *Expression) (common.
 	}
 
 	switch list := element.(type) {
-	case types.ListInstance:
+	case *types.ListInstance:
 		lhsLen := int64(len(lhs))
 		rhsLen := list.Length(state)
 		if lhsLen > rhsLen {
@@ -280,7 +315,7 @@ func unpackList(state common.State, lhs []*Expression, rhs *Expression) (common.
 
 		if i < list.Length(state)-1 {
 			rest := types.NewListInstance()
-			rest.Values = list.Values[i:]
+			rest.Values = append(rest.Values, list.Values[i:]...)
 			resultList.Values = append(resultList.Values, rest)
 		} else {
 			element, err := lhs[i].Evaluate(state, list.Values[i])
@@ -298,9 +333,26 @@ func unpackList(state common.State, lhs []*Expression, rhs *Expression) (common.
 	panic(fmt.Sprintf("unable to unpack %s", element.GetTypeName()))
 }
 
-func evalReturnTypes(state common.State, returnTypes []*ReturnType) ([]types.FunctionReturnType, error) {
+func evalReturnTypes(
+	state common.State,
+	returnTypes []*ReturnType,
+	isGenerator bool,
+) ([]types.FunctionReturnType, error) {
 	var result []types.FunctionReturnType
-	if len(returnTypes) == 0 {
+	if isGenerator {
+		if len(returnTypes) > 1 || (len(returnTypes) == 1 && returnTypes[0].Type.String() != common.GeneratorTypeName) {
+			return nil, util.RuntimeError(
+				fmt.Sprintf("функція, що містить 'видати', має повертати '%s'", common.GeneratorTypeName),
+			)
+		}
+
+		result = append(
+			result, types.FunctionReturnType{
+				Type:       types.Generator,
+				IsNullable: false,
+			},
+		)
+	} else if len(returnTypes) == 0 {
 		result = append(
 			result, types.FunctionReturnType{
 				Type:       types.Nil,
@@ -321,19 +373,19 @@ func evalReturnTypes(state common.State, returnTypes []*ReturnType) ([]types.Fun
 	return result, nil
 }
 
-func getCurrentValue(ctx common.Context, prevValue common.Value, ident string) (common.Value, error) {
+func getCurrentValue(state common.State, prevValue common.Value, ident string) (common.Value, error) {
 	if prevValue != nil {
 		if err := checkForNilAttribute(ident); err != nil {
 			return nil, err
 		}
 
-		return prevValue.GetAttribute(ident)
+		return prevValue.GetAttribute(state, ident)
 	}
 
-	return ctx.GetVar(ident)
+	return state.GetContext().GetVar(ident)
 }
 
-func setCurrentValue(ctx common.Context, prevValue common.Value, ident string, valueToSet common.Value) (
+func setCurrentValue(state common.State, prevValue common.Value, ident string, valueToSet common.Value) (
 	common.Value,
 	error,
 ) {
@@ -342,10 +394,10 @@ func setCurrentValue(ctx common.Context, prevValue common.Value, ident string, v
 			return nil, err
 		}
 
-		return prevValue, prevValue.SetAttribute(ident, valueToSet)
+		return prevValue, prevValue.SetAttribute(state, ident, valueToSet)
 	}
 
-	return valueToSet, ctx.SetVar(ident, valueToSet)
+	return valueToSet, state.GetContext().SetVar(ident, valueToSet)
 }
 
 func checkForNilAttribute(ident string) error {
@@ -369,3 +421,38 @@ func updateArgs(state common.State, arguments []*Expression, args *[]common.Valu
 
 	return nil
 }
+
+// containsYield walks through the AST node and checks if it contains
+// 'видати' expression outside of nested function, lambda and class
+// definitions.
+func containsYield(node reflect.Value) bool {
+	switch node.Kind() {
+	case reflect.Ptr:
+		if node.IsNil() {
+			return false
+		}
+
+		switch node.Interface().(type) {
+		case *YieldExpr:
+			return true
+		case *FunctionDef, *LambdaDef, *ClassDef:
+			return false
+		}
+
+		return containsYield(node.Elem())
+	case reflect.Slice:
+		for i := 0; i < node.Len(); i++ {
+			if containsYield(node.Index(i)) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < node.NumField(); i++ {
+			if containsYield(node.Field(i)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}